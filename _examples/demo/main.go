@@ -13,7 +13,6 @@ import (
 	"github.com/fglo/chopstiqs"
 	"github.com/fglo/chopstiqs/component"
 	"github.com/fglo/chopstiqs/debug"
-	"github.com/fglo/chopstiqs/input"
 	"github.com/fglo/chopstiqs/option"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -88,7 +87,8 @@ func NewGame() *Game {
 
 	cbOpts := &component.CheckBoxOptions{
 		Drawer: component.DefaultCheckBoxDrawer{
-			Color: color.RGBA{255, 100, 50, 255},
+			Color:        color.RGBA{255, 100, 50, 255},
+			ColorFocused: color.RGBA{90, 160, 250, 255},
 		},
 		Width: option.Int(15),
 	}
@@ -106,6 +106,7 @@ func NewGame() *Game {
 			ColorPressed:  color.RGBA{90, 160, 80, 255},
 			ColorHovered:  color.RGBA{120, 190, 100, 255},
 			ColorDisabled: color.RGBA{80, 100, 70, 255},
+			ColorFocused:  color.RGBA{90, 160, 250, 255},
 		},
 	})
 
@@ -281,15 +282,14 @@ func (g *Game) Update() error {
 	_pressedKeys := make([]string, 0)
 	_justPressedKeys := make([]string, 0)
 
-	for key, justPressed := range input.KeyJustPressed {
-		if justPressed {
-			_justPressedKeys = append(_justPressedKeys, ebiten.Key(key).String())
+	in := g.gui.Input()
+	for key := ebiten.Key(0); key <= ebiten.KeyMax; key++ {
+		if in.KeyJustPressed(key) {
+			_justPressedKeys = append(_justPressedKeys, key.String())
 		}
-	}
 
-	for key, pressed := range input.KeyPressed {
-		if pressed {
-			_pressedKeys = append(_pressedKeys, ebiten.Key(key).String())
+		if in.KeyPressed(key) {
+			_pressedKeys = append(_pressedKeys, key.String())
 		}
 	}
 
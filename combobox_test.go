@@ -0,0 +1,113 @@
+package chopstiqs
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func newTestComboBox() *component.ComboBox {
+	return component.NewComboBox(&component.ComboBoxOptions{
+		Items: []component.ComboBoxItem{
+			{Label: "640x480", Value: 0},
+			{Label: "800x600", Value: 1},
+			{Label: "1024x768", Value: 2},
+		},
+		Width: option.Int(60),
+	})
+}
+
+func TestGUI_ComboBox(t *testing.T) {
+	is := is.New(t)
+
+	cb := newTestComboBox()
+	below := component.NewButton(nil)
+	gui, in := newTestGUI(t, cb, below)
+	step(t, gui)
+
+	belowClicked := 0
+	below.AddClickedHandler(func(args *component.ButtonClickedEventArgs) { belowClicked++ })
+
+	clickAt(t, gui, in, 5, 5)
+	is.True(cb.IsOpen())
+
+	popups := gui.layers[LayerPopup].Components()
+	is.Equal(len(popups), 1)
+
+	x, y := popups[0].Position()
+	is.Equal(x, 0.0)
+	is.Equal(y, float64(cb.HeightWithPadding())) // the list is placed below the combo box
+
+	// the list covers the button below the combo box
+	clickAt(t, gui, in, 5, 15+2*cb.Height()+5)
+	is.Equal(belowClicked, 0)
+	is.True(!cb.IsOpen())
+	is.Equal(cb.SelectedIndex(), 2)
+	is.Equal(gui.FocusedComponent(), cb)
+	is.Equal(len(gui.layers[LayerPopup].Components()), 0)
+
+	// clicking outside closes the list
+	clickAt(t, gui, in, 5, 5)
+	is.True(cb.IsOpen())
+	clickAt(t, gui, in, 150, 150)
+	is.True(!cb.IsOpen())
+	is.Equal(cb.SelectedIndex(), 2)
+}
+
+func TestGUI_ComboBox_keyboard(t *testing.T) {
+	is := is.New(t)
+
+	cb := newTestComboBox()
+	gui, in := newTestGUI(t, cb)
+	gui.Focus(cb)
+	step(t, gui)
+
+	pressKey(t, gui, in, ebiten.KeySpace)
+	is.True(cb.IsOpen())
+
+	pressKey(t, gui, in, ebiten.KeyDown)
+	pressKey(t, gui, in, ebiten.KeyEnter)
+	is.True(!cb.IsOpen())
+	is.Equal(cb.SelectedIndex(), 0)
+
+	// moving the focus away closes the list
+	pressKey(t, gui, in, ebiten.KeyEnter)
+	is.True(cb.IsOpen())
+	gui.Unfocus()
+	step(t, gui)
+	is.True(!cb.IsOpen())
+}
+
+func TestGUI_ComboBox_inDialog(t *testing.T) {
+	is := is.New(t)
+
+	gui, in := newTestGUI(t, component.NewButton(nil))
+
+	cb := newTestComboBox()
+	d := newTestDialog(cb)
+	gui.OpenDialog(d)
+	step(t, gui)
+
+	cbX, cbY := cb.AbsPosition()
+	clickAt(t, gui, in, int(cbX)+5, int(cbY)+5)
+	is.True(cb.IsOpen())
+
+	// the popup layer is above the modal layer, so the list takes the clicks
+	clickAt(t, gui, in, int(cbX)+5, int(cbY)+cb.HeightWithPadding()+cb.Height()+5)
+	is.True(!cb.IsOpen())
+	is.Equal(cb.SelectedIndex(), 1)
+	is.True(!d.Hidden())
+
+	// Escape closes the list, not the dialog
+	clickAt(t, gui, in, int(cbX)+5, int(cbY)+5)
+	is.True(cb.IsOpen())
+	pressKey(t, gui, in, ebiten.KeyEscape)
+	is.True(!cb.IsOpen())
+	is.True(!d.Hidden())
+
+	pressKey(t, gui, in, ebiten.KeyEscape)
+	is.True(d.Hidden())
+}
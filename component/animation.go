@@ -0,0 +1,63 @@
+package component
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/fglo/chopstiqs/tween"
+)
+
+// BackgroundColorer is implemented by the components with a background color.
+type BackgroundColorer interface {
+	SetBackgroundColor(color color.RGBA)
+	GetBackgroundColor() color.RGBA
+}
+
+// MoveTo creates a tween moving the component from the position it has when the tween starts to the position (x, y).
+func MoveTo(c Component, x, y float64, duration time.Duration, easing tween.Easing) *tween.Tween {
+	var fromX, fromY float64
+
+	return tween.New(duration, easing, func(progress float64) {
+		c.SetPosition(tween.Lerp(fromX, x, progress), tween.Lerp(fromY, y, progress))
+	}).OnStart(func() {
+		fromX, fromY = c.Position()
+	})
+}
+
+// ResizeTo creates a tween resizing the component from the dimensions it has when the tween starts to the given ones.
+func ResizeTo(c Component, width, height int, duration time.Duration, easing tween.Easing) *tween.Tween {
+	var fromWidth, fromHeight float64
+
+	return tween.New(duration, easing, func(progress float64) {
+		c.SetDimensions(
+			int(math.Round(tween.Lerp(fromWidth, float64(width), progress))),
+			int(math.Round(tween.Lerp(fromHeight, float64(height), progress))),
+		)
+	}).OnStart(func() {
+		fromWidth, fromHeight = float64(c.Width()), float64(c.Height())
+	})
+}
+
+// FadeTo creates a tween changing the component's alpha from the one it has when the tween starts to the given one.
+func FadeTo(c Component, alpha float64, duration time.Duration, easing tween.Easing) *tween.Tween {
+	var from float64
+
+	return tween.New(duration, easing, func(progress float64) {
+		c.SetAlpha(tween.Lerp(from, alpha, progress))
+	}).OnStart(func() {
+		from = c.Alpha()
+	})
+}
+
+// BackgroundColorTo creates a tween changing the component's background color
+// from the one it has when the tween starts to the given one.
+func BackgroundColorTo(c BackgroundColorer, clr color.RGBA, duration time.Duration, easing tween.Easing) *tween.Tween {
+	var from color.RGBA
+
+	return tween.New(duration, easing, func(progress float64) {
+		c.SetBackgroundColor(tween.LerpColor(from, clr, progress))
+	}).OnStart(func() {
+		from = c.GetBackgroundColor()
+	})
+}
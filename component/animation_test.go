@@ -0,0 +1,41 @@
+package component
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/tween"
+	"github.com/matryer/is"
+)
+
+func TestAnimation_tweens(t *testing.T) {
+	is := is.New(t)
+
+	c := NewContainer(&ContainerOptions{Width: option.Int(10), Height: option.Int(10)})
+	c.SetPosition(0, 100)
+	c.SetBackgroundColor(color.RGBA{0, 0, 0, 255})
+
+	animation := tween.Parallel(
+		MoveTo(c, 100, 0, time.Second, tween.Linear),
+		ResizeTo(c, 20, 30, time.Second, tween.Linear),
+		FadeTo(c, 0, time.Second, tween.Linear),
+		BackgroundColorTo(c, color.RGBA{200, 100, 0, 255}, time.Second, tween.Linear),
+	)
+
+	animation.Update(500 * time.Millisecond)
+	x, y := c.Position()
+	is.Equal(x, 50.0)
+	is.Equal(y, 50.0)
+	is.Equal(c.Width(), 15)
+	is.Equal(c.Height(), 20)
+	is.Equal(c.Alpha(), 0.5)
+	is.Equal(c.GetBackgroundColor(), color.RGBA{100, 50, 0, 255})
+
+	finished, _ := animation.Update(500 * time.Millisecond)
+	is.True(finished)
+	is.Equal(c.PosX(), 100.0)
+	is.Equal(c.Width(), 20)
+	is.Equal(c.Alpha(), 0.0)
+}
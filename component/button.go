@@ -2,9 +2,12 @@ package component
 
 import (
 	"image/color"
+	"time"
 
 	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
 	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -13,14 +16,21 @@ type Button struct {
 
 	pressed  bool
 	hovering bool
+	// latched keeps the button drawn as pressed after it's released, like a checked toggle button
+	latched bool
 
-	PressedEvent  *event.Event
-	ReleasedEvent *event.Event
-	ClickedEvent  *event.Event
+	PressedEvent  *event.Event[*ButtonPressedEventArgs]
+	ReleasedEvent *event.Event[*ButtonReleasedEventArgs]
+	ClickedEvent  *event.Event[*ButtonClickedEventArgs]
 
 	label *Label
 
 	drawer ButtonDrawer
+
+	autoRepeat      bool
+	autoRepeatTimer *timer.Handle
+	// autoRepeated is set when the button was clicked by the auto repeat since it was pressed
+	autoRepeated bool
 }
 
 type ButtonOptions struct {
@@ -32,8 +42,15 @@ type ButtonOptions struct {
 	Label *Label
 
 	Padding *Padding
+	Tooltip *Tooltip
+
+	// AutoRepeat makes the button click repeatedly while it's held down, like the arrows of a spinner.
+	AutoRepeat bool
 }
 
+var buttonAutoRepeatDelay = 400 * time.Millisecond
+var buttonAutoRepeatInterval = 80 * time.Millisecond
+
 type ButtonPressedEventArgs struct {
 	Button *Button
 }
@@ -54,17 +71,24 @@ type ButtonReleasedHandlerFunc func(args *ButtonReleasedEventArgs)
 type ButtonClickedHandlerFunc func(args *ButtonClickedEventArgs)
 
 func NewButton(opt *ButtonOptions) *Button {
-	b := &Button{
-		PressedEvent:  &event.Event{},
-		ReleasedEvent: &event.Event{},
-		ClickedEvent:  &event.Event{},
-
-		drawer: &DefaultButtonDrawer{
-			Color:         color.RGBA{230, 230, 230, 255},
-			ColorPressed:  color.RGBA{200, 200, 200, 255},
-			ColorHovered:  color.RGBA{250, 250, 250, 255},
-			ColorDisabled: color.RGBA{150, 150, 150, 255},
-		},
+	b := &Button{}
+	b.init(opt)
+
+	return b
+}
+
+// init sets up the button in place, so it can be embedded in other components, like the toggle button.
+func (b *Button) init(opt *ButtonOptions) {
+	b.PressedEvent = &event.Event[*ButtonPressedEventArgs]{}
+	b.ReleasedEvent = &event.Event[*ButtonReleasedEventArgs]{}
+	b.ClickedEvent = &event.Event[*ButtonClickedEventArgs]{}
+
+	b.drawer = &DefaultButtonDrawer{
+		Color:         color.RGBA{230, 230, 230, 255},
+		ColorPressed:  color.RGBA{200, 200, 200, 255},
+		ColorHovered:  color.RGBA{250, 250, 250, 255},
+		ColorDisabled: color.RGBA{150, 150, 150, 255},
+		ColorFocused:  color.RGBA{90, 160, 250, 255},
 	}
 
 	width := 45
@@ -86,23 +110,23 @@ func NewButton(opt *ButtonOptions) *Button {
 		if opt.Label != nil {
 			b.SetLabel(opt.Label)
 
-			b.PressedEvent.AddHandler(func(args interface{}) {
+			b.PressedEvent.AddHandler(func(args *ButtonPressedEventArgs) {
 				b.label.Inverted = true
 			})
 
-			b.ReleasedEvent.AddHandler(func(args interface{}) {
-				b.label.Inverted = false
+			b.ReleasedEvent.AddHandler(func(args *ButtonReleasedEventArgs) {
+				b.label.Inverted = b.latched
 			})
 		}
 
+		b.autoRepeat = opt.AutoRepeat
+
 		if opt.Drawer != nil {
 			b.drawer = opt.Drawer
 		}
 	}
 
 	b.setUpComponent(opt)
-
-	return b
 }
 
 func (b *Button) setUpComponent(opt *ButtonOptions) {
@@ -111,40 +135,47 @@ func (b *Button) setUpComponent(opt *ButtonOptions) {
 	if opt != nil {
 		componentOptions = ComponentOptions{
 			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
 		}
 	}
 
 	b.component.setUpComponent(&componentOptions)
+	b.focusable = true
 
-	b.component.AddCursorEnterHandler(func(args *ComponentCursorEnterEventArgs) {
+	b.CursorEnterEvent.AddDefaultHandler(func(args *ComponentCursorEnterEventArgs) {
 		if !b.disabled {
 			b.hovering = true
 		}
 	})
 
-	b.component.AddCursorExitHandler(func(args *ComponentCursorExitEventArgs) {
+	b.CursorExitEvent.AddDefaultHandler(func(args *ComponentCursorExitEventArgs) {
 		b.hovering = false
 	})
 
-	b.component.AddMouseButtonPressedHandler(func(args *ComponentMouseButtonPressedEventArgs) {
+	b.MouseButtonPressedEvent.AddDefaultHandler(func(args *ComponentMouseButtonPressedEventArgs) {
 		if !b.disabled && args.Button == ebiten.MouseButtonLeft {
+			if !b.pressed && b.autoRepeat {
+				b.startAutoRepeat()
+			}
+
 			b.pressed = true
-			b.eventManager.Fire(b.PressedEvent, &ButtonPressedEventArgs{
+			event.Fire(b.eventManager, b.PressedEvent, &ButtonPressedEventArgs{
 				Button: b,
 			})
 		}
 	})
 
-	b.component.AddMouseButtonReleasedHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+	b.MouseButtonReleasedEvent.AddDefaultHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
 		if b.pressed && args.Button == ebiten.MouseButtonLeft {
 			b.pressed = false
-			b.eventManager.Fire(b.ReleasedEvent, &ButtonReleasedEventArgs{
+			b.autoRepeatTimer.Cancel()
+			event.Fire(b.eventManager, b.ReleasedEvent, &ButtonReleasedEventArgs{
 				Button: b,
 				Inside: args.Inside,
 			})
 
-			if !b.disabled {
-				b.eventManager.Fire(b.ClickedEvent, &ButtonClickedEventArgs{
+			if !b.disabled && !b.autoRepeated {
+				event.Fire(b.eventManager, b.ClickedEvent, &ButtonClickedEventArgs{
 					Button: b,
 				})
 			}
@@ -152,22 +183,65 @@ func (b *Button) setUpComponent(opt *ButtonOptions) {
 	})
 }
 
-func (b *Button) AddPressedHandler(f ButtonPressedHandlerFunc) *Button {
-	b.PressedEvent.AddHandler(func(args interface{}) { f(args.(*ButtonPressedEventArgs)) })
+// startAutoRepeat starts clicking the pressed button after the auto repeat delay.
+func (b *Button) startAutoRepeat() {
+	scheduler := b.eventManager.Scheduler()
 
-	return b
+	b.autoRepeated = false
+	b.autoRepeatTimer.Cancel()
+	b.autoRepeatTimer = scheduler.After(buttonAutoRepeatDelay, func() {
+		b.autoRepeatTimer = scheduler.Every(buttonAutoRepeatInterval, b.repeatClick)
+		b.repeatClick()
+	})
 }
 
-func (b *Button) AddReleasedHandler(f ButtonReleasedHandlerFunc) *Button {
-	b.ReleasedEvent.AddHandler(func(args interface{}) { f(args.(*ButtonReleasedEventArgs)) })
+func (b *Button) repeatClick() {
+	if !b.pressed || b.disabled {
+		b.autoRepeatTimer.Cancel()
+		return
+	}
 
-	return b
+	b.autoRepeated = true
+	event.Fire(b.eventManager, b.ClickedEvent, &ButtonClickedEventArgs{
+		Button: b,
+	})
 }
 
-func (b *Button) AddClickedHandler(f ButtonClickedHandlerFunc) *Button {
-	b.ClickedEvent.AddHandler(func(args interface{}) { f(args.(*ButtonClickedEventArgs)) })
+func (b *Button) AddPressedHandler(f ButtonPressedHandlerFunc) event.RemoveHandlerFunc {
+	return b.PressedEvent.AddHandler(event.HandlerFunc[*ButtonPressedEventArgs](f))
+}
 
-	return b
+func (b *Button) AddReleasedHandler(f ButtonReleasedHandlerFunc) event.RemoveHandlerFunc {
+	return b.ReleasedEvent.AddHandler(event.HandlerFunc[*ButtonReleasedEventArgs](f))
+}
+
+func (b *Button) AddClickedHandler(f ButtonClickedHandlerFunc) event.RemoveHandlerFunc {
+	return b.ClickedEvent.AddHandler(event.HandlerFunc[*ButtonClickedEventArgs](f))
+}
+
+// setLatched sets whether the button stays drawn as pressed.
+func (b *Button) setLatched(latched bool) {
+	b.latched = latched
+	if b.label != nil && !b.pressed {
+		b.label.Inverted = latched
+	}
+}
+
+// Activate clicks the button as if it was clicked with the mouse.
+// It is called when the button is focused and activated with the keyboard.
+func (b *Button) Activate() {
+	if b.disabled {
+		return
+	}
+
+	event.Fire(b.eventManager, b.ClickedEvent, &ButtonClickedEventArgs{
+		Button: b,
+	})
+}
+
+// Label returns the label of the button, or nil if it has none.
+func (b *Button) Label() *Label {
+	return b.label
 }
 
 // SetLabel sets the label of the button and sets the dimensions of the button accordingly.
@@ -215,12 +289,12 @@ func (b *Button) GetBackgroundColor() color.RGBA {
 	return b.container.GetBackgroundColor()
 }
 
-func (b *Button) FireEvents() {
+func (b *Button) FireEvents(in input.InputSource) {
 	if b.label != nil {
-		b.label.FireEvents()
+		b.label.FireEvents(in)
 	}
 
-	b.component.FireEvents()
+	b.component.FireEvents(in)
 }
 
 func (b *Button) Draw() *ebiten.Image {
@@ -15,10 +15,12 @@ type DefaultButtonDrawer struct {
 	ColorPressed  color.RGBA
 	ColorHovered  color.RGBA
 	ColorDisabled color.RGBA
+	// ColorFocused is the color of the focused button's border. If it's transparent, the focus isn't drawn.
+	ColorFocused color.RGBA
 }
 
 func (d DefaultButtonDrawer) Draw(bttn *Button) *ebiten.Image {
-	if bttn.pressed {
+	if bttn.pressed || bttn.latched {
 		bttn.image.WritePixels(d.drawPressed(bttn))
 	} else if bttn.hovering {
 		bttn.image.WritePixels(d.drawHovered(bttn))
@@ -43,9 +45,19 @@ func (d *DefaultButtonDrawer) isColored(bttn *Button, rowId, colId int) bool {
 	return colId > bttn.secondPixelColId && colId < bttn.penultimatePixelColId && rowId > bttn.secondPixelRowId && rowId < bttn.penultimatePixelRowId
 }
 
+// borderColor returns ColorFocused while the button is focused, or the color of the button's state otherwise.
+func (d *DefaultButtonDrawer) borderColor(bttn *Button, stateColor color.RGBA) color.RGBA {
+	if bttn.focused && d.ColorFocused.A > 0 {
+		return d.ColorFocused
+	}
+
+	return stateColor
+}
+
 func (d *DefaultButtonDrawer) draw(bttn *Button) []byte {
 	arr := make([]byte, bttn.pixelRows*bttn.pixelCols)
 	backgroundColor := bttn.container.GetBackgroundColor()
+	borderColor := d.borderColor(bttn, d.Color)
 
 	for rowId := bttn.firstPixelRowId; rowId <= bttn.lastPixelRowId; rowId++ {
 		rowNumber := bttn.pixelCols * rowId
@@ -56,7 +68,12 @@ func (d *DefaultButtonDrawer) draw(bttn *Button) []byte {
 				arr[colId+1+rowNumber] = backgroundColor.G
 				arr[colId+2+rowNumber] = backgroundColor.B
 				arr[colId+3+rowNumber] = backgroundColor.A
-			} else if d.isBorder(bttn, rowId, colId) || d.isColored(bttn, rowId, colId) {
+			} else if d.isBorder(bttn, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else if d.isColored(bttn, rowId, colId) {
 				arr[colId+rowNumber] = d.Color.R
 				arr[colId+1+rowNumber] = d.Color.G
 				arr[colId+2+rowNumber] = d.Color.B
@@ -76,6 +93,7 @@ func (d *DefaultButtonDrawer) draw(bttn *Button) []byte {
 func (d *DefaultButtonDrawer) drawPressed(bttn *Button) []byte {
 	arr := make([]byte, bttn.pixelRows*bttn.pixelCols)
 	backgroundColor := bttn.container.GetBackgroundColor()
+	borderColor := d.borderColor(bttn, d.ColorPressed)
 
 	for rowId := bttn.firstPixelRowId; rowId <= bttn.lastPixelRowId; rowId++ {
 		rowNumber := bttn.pixelCols * rowId
@@ -87,10 +105,10 @@ func (d *DefaultButtonDrawer) drawPressed(bttn *Button) []byte {
 				arr[colId+2+rowNumber] = backgroundColor.B
 				arr[colId+3+rowNumber] = backgroundColor.A
 			} else if d.isBorder(bttn, rowId, colId) {
-				arr[colId+rowNumber] = d.ColorPressed.R
-				arr[colId+1+rowNumber] = d.ColorPressed.G
-				arr[colId+2+rowNumber] = d.ColorPressed.B
-				arr[colId+3+rowNumber] = d.ColorPressed.A
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
 			} else {
 				arr[colId+rowNumber] = backgroundColor.R
 				arr[colId+1+rowNumber] = backgroundColor.G
@@ -106,6 +124,7 @@ func (d *DefaultButtonDrawer) drawPressed(bttn *Button) []byte {
 func (d *DefaultButtonDrawer) drawHovered(bttn *Button) []byte {
 	arr := make([]byte, bttn.pixelRows*bttn.pixelCols)
 	backgroundColor := bttn.container.GetBackgroundColor()
+	borderColor := d.borderColor(bttn, d.ColorHovered)
 
 	for rowId := bttn.firstPixelRowId; rowId <= bttn.lastPixelRowId; rowId++ {
 		rowNumber := bttn.pixelCols * rowId
@@ -116,7 +135,12 @@ func (d *DefaultButtonDrawer) drawHovered(bttn *Button) []byte {
 				arr[colId+1+rowNumber] = backgroundColor.G
 				arr[colId+2+rowNumber] = backgroundColor.B
 				arr[colId+3+rowNumber] = backgroundColor.A
-			} else if d.isBorder(bttn, rowId, colId) || d.isColored(bttn, rowId, colId) {
+			} else if d.isBorder(bttn, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else if d.isColored(bttn, rowId, colId) {
 				arr[colId+rowNumber] = d.ColorHovered.R
 				arr[colId+1+rowNumber] = d.ColorHovered.G
 				arr[colId+2+rowNumber] = d.ColorHovered.B
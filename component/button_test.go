@@ -2,8 +2,10 @@ package component
 
 import (
 	"testing"
+	"time"
 
 	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/timer"
 	"github.com/matryer/is"
 )
 
@@ -75,3 +77,64 @@ func TestButton_Released(t *testing.T) {
 	leftMouseButtonRelease(t, &b.component)
 	is.Equal(firedEventsCounter, 2)
 }
+
+func TestButton_RemoveClickedHandler(t *testing.T) {
+	is := is.New(t)
+
+	firedEventsCounter := 0
+
+	eventManager := event.NewManager()
+
+	b := NewButton(&ButtonOptions{})
+	b.SetEventManager(eventManager)
+	remove := b.AddClickedHandler(func(args *ButtonClickedEventArgs) {
+		firedEventsCounter++
+	})
+
+	leftMouseButtonClick(t, &b.component)
+	is.Equal(firedEventsCounter, 1)
+
+	remove()
+
+	leftMouseButtonClick(t, &b.component)
+	is.Equal(firedEventsCounter, 1)
+}
+
+func TestButton_AutoRepeat(t *testing.T) {
+	is := is.New(t)
+
+	clock := timer.NewFakeClock()
+	eventManager := event.NewManager()
+	eventManager.SetScheduler(timer.NewScheduler(clock))
+
+	firedEventsCounter := 0
+
+	b := NewButton(&ButtonOptions{AutoRepeat: true})
+	b.SetEventManager(eventManager)
+	b.AddClickedHandler(func(args *ButtonClickedEventArgs) {
+		firedEventsCounter++
+	})
+
+	wait := func(d time.Duration) {
+		clock.Advance(d)
+		eventManager.Scheduler().Tick()
+		eventManager.HandleFired()
+	}
+
+	leftMouseButtonPress(t, &b.component)
+	wait(buttonAutoRepeatDelay - time.Millisecond)
+	is.Equal(firedEventsCounter, 0)
+
+	wait(time.Millisecond)
+	is.Equal(firedEventsCounter, 1)
+
+	wait(buttonAutoRepeatInterval)
+	wait(buttonAutoRepeatInterval)
+	is.Equal(firedEventsCounter, 3)
+
+	leftMouseButtonRelease(t, &b.component)
+	is.Equal(firedEventsCounter, 3) // the release doesn't click after the repeated clicks
+
+	wait(buttonAutoRepeatInterval)
+	is.Equal(firedEventsCounter, 3)
+}
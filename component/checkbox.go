@@ -4,15 +4,27 @@ import (
 	"image/color"
 
 	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
 	"github.com/fglo/chopstiqs/option"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
+// CheckBoxState is the state of a checkbox.
+type CheckBoxState int
+
+const (
+	CheckBoxUnchecked CheckBoxState = iota
+	CheckBoxChecked
+	// CheckBoxIndeterminate is the state of a checkbox that is neither checked nor unchecked,
+	// like a "select all" checkbox when only some of the items are selected. It can only be set with SetState.
+	CheckBoxIndeterminate
+)
+
 type CheckBox struct {
 	component
-	checked bool
+	state CheckBoxState
 
-	ToggledEvent *event.Event
+	ToggledEvent *event.Event[*CheckBoxToggledEventArgs]
 
 	label *Label
 
@@ -39,12 +51,15 @@ type CheckBoxOptions struct {
 	Label *Label
 
 	Padding *Padding
+	Tooltip *Tooltip
 
 	Drawer CheckBoxDrawer
 }
 
 type CheckBoxToggledEventArgs struct {
-	CheckBox *CheckBox
+	CheckBox      *CheckBox
+	State         CheckBoxState
+	PreviousState CheckBoxState
 }
 
 type CheckBoxToggledHandlerFunc func(args *CheckBoxToggledEventArgs)
@@ -52,14 +67,15 @@ type CheckBoxToggledHandlerFunc func(args *CheckBoxToggledEventArgs)
 func NewCheckBox(opt *CheckBoxOptions) *CheckBox {
 
 	cb := &CheckBox{
-		checked:      false,
-		ToggledEvent: &event.Event{},
+		state:        CheckBoxUnchecked,
+		ToggledEvent: &event.Event[*CheckBoxToggledEventArgs]{},
 
 		cbWidth:  10,
 		cbHeight: 10,
 
 		drawer: DefaultCheckBoxDrawer{
-			Color: color.RGBA{230, 230, 230, 255},
+			Color:        color.RGBA{230, 230, 230, 255},
+			ColorFocused: color.RGBA{90, 160, 250, 255},
 		},
 	}
 
@@ -103,17 +119,16 @@ func (cb *CheckBox) setUpComponent(opt *CheckBoxOptions) {
 	if opt != nil {
 		componentOptions = ComponentOptions{
 			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
 		}
 	}
 
 	cb.component.setUpComponent(&componentOptions)
+	cb.focusable = true
 
-	cb.component.AddMouseButtonReleasedHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+	cb.MouseButtonReleasedEvent.AddDefaultHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
 		if !cb.disabled && args.Inside {
-			cb.checked = !cb.checked
-			cb.eventManager.Fire(cb.ToggledEvent, &CheckBoxToggledEventArgs{
-				CheckBox: cb,
-			})
+			cb.Toggle()
 		}
 	})
 }
@@ -132,10 +147,23 @@ func (cb *CheckBox) setDrawingDimensions() {
 	cb.penultimatePixelRowId = cb.lastPixelRowId - 1
 }
 
-func (cb *CheckBox) AddToggledHandler(f CheckBoxToggledHandlerFunc) *CheckBox {
-	cb.ToggledEvent.AddHandler(func(args interface{}) { f(args.(*CheckBoxToggledEventArgs)) })
+func (cb *CheckBox) AddToggledHandler(f CheckBoxToggledHandlerFunc) event.RemoveHandlerFunc {
+	return cb.ToggledEvent.AddHandler(event.HandlerFunc[*CheckBoxToggledEventArgs](f))
+}
 
-	return cb
+// AddCheckedChangedHandler registers a handler called when the checkbox is checked or unchecked.
+// Changes between the unchecked and the indeterminate states don't change whether it's checked, so they aren't reported.
+func (cb *CheckBox) AddCheckedChangedHandler(f CheckedChangedHandlerFunc) event.RemoveHandlerFunc {
+	return cb.AddToggledHandler(func(args *CheckBoxToggledEventArgs) {
+		if (args.State == CheckBoxChecked) != (args.PreviousState == CheckBoxChecked) {
+			f(&CheckedChangedEventArgs{Toggler: cb, Checked: args.State == CheckBoxChecked})
+		}
+	})
+}
+
+// Label returns the label of the checkbox, or nil if it has none.
+func (cb *CheckBox) Label() *Label {
+	return cb.label
 }
 
 // SetLabel sets the label of the checkbox and adjusts the checkbox's dimensions accordingly.
@@ -165,24 +193,97 @@ func (cb *CheckBox) SetLabel(label *Label) {
 }
 
 func (cb *CheckBox) Set(checked bool) {
-	prevState := cb.checked
-	cb.checked = checked
-	if prevState != cb.checked {
-		cb.eventManager.Fire(cb.ToggledEvent, &CheckBoxToggledEventArgs{
-			CheckBox: cb,
-		})
+	if checked {
+		cb.SetState(CheckBoxChecked)
+	} else {
+		cb.SetState(CheckBoxUnchecked)
 	}
 }
 
+// Checked returns whether the checkbox is checked. An indeterminate checkbox isn't checked.
 func (cb *CheckBox) Checked() bool {
-	return cb.checked
+	return cb.state == CheckBoxChecked
 }
 
+// State returns the state of the checkbox.
+func (cb *CheckBox) State() CheckBoxState {
+	return cb.state
+}
+
+// SetState sets the state of the checkbox. The ToggledEvent is fired only if the state changes.
+func (cb *CheckBox) SetState(state CheckBoxState) {
+	prevState := cb.state
+	cb.state = state
+	if prevState != cb.state {
+		event.Fire(cb.eventManager, cb.ToggledEvent, &CheckBoxToggledEventArgs{
+			CheckBox:      cb,
+			State:         cb.state,
+			PreviousState: prevState,
+		})
+	}
+}
+
+// Toggle unchecks a checked checkbox and checks an unchecked or indeterminate one.
 func (cb *CheckBox) Toggle() {
-	cb.checked = !cb.checked
-	cb.eventManager.Fire(cb.ToggledEvent, &CheckBoxToggledEventArgs{
-		CheckBox: cb,
-	})
+	cb.Set(!cb.Checked())
+}
+
+// BindCheckBoxes binds the parent checkbox to the child checkboxes. The parent is checked when all of the children are checked,
+// unchecked when none of them is and indeterminate otherwise. Toggling the parent sets all of the children to its state.
+// It returns a function that unbinds the checkboxes.
+func BindCheckBoxes(parent *CheckBox, children ...*CheckBox) event.RemoveHandlerFunc {
+	update := func() {
+		checked := 0
+		for _, child := range children {
+			if child.Checked() {
+				checked++
+			}
+		}
+
+		switch checked {
+		case 0:
+			parent.SetState(CheckBoxUnchecked)
+		case len(children):
+			parent.SetState(CheckBoxChecked)
+		default:
+			parent.SetState(CheckBoxIndeterminate)
+		}
+	}
+
+	removeHandlers := make([]event.RemoveHandlerFunc, 0, len(children)+1)
+
+	removeHandlers = append(removeHandlers, parent.AddToggledHandler(func(args *CheckBoxToggledEventArgs) {
+		// the parent becomes indeterminate only when the children disagree, so they are left as they are
+		if args.State == CheckBoxIndeterminate {
+			return
+		}
+
+		for _, child := range children {
+			child.SetState(args.State)
+		}
+	}))
+
+	for _, child := range children {
+		removeHandlers = append(removeHandlers, child.AddToggledHandler(func(args *CheckBoxToggledEventArgs) {
+			update()
+		}))
+	}
+
+	update()
+
+	return func() {
+		for _, remove := range removeHandlers {
+			remove()
+		}
+	}
+}
+
+// Activate toggles the checkbox as if it was clicked with the mouse.
+// It is called when the checkbox is focused and activated with the keyboard.
+func (cb *CheckBox) Activate() {
+	if !cb.disabled {
+		cb.Toggle()
+	}
 }
 
 func (cb *CheckBox) SetPosition(posX, posY float64) {
@@ -207,12 +308,12 @@ func (cb *CheckBox) GetBackgroundColor() color.RGBA {
 	return cb.container.GetBackgroundColor()
 }
 
-func (cb *CheckBox) FireEvents() {
+func (cb *CheckBox) FireEvents(in input.InputSource) {
 	if cb.label != nil {
-		cb.label.FireEvents()
+		cb.label.FireEvents(in)
 	}
 
-	cb.component.FireEvents()
+	cb.component.FireEvents(in)
 }
 
 func (cb *CheckBox) Draw() *ebiten.Image {
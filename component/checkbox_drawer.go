@@ -12,18 +12,32 @@ type CheckBoxDrawer interface {
 
 type DefaultCheckBoxDrawer struct {
 	Color color.RGBA
+	// ColorFocused is the color of the focused checkbox's border. If it's transparent, the focus isn't drawn.
+	ColorFocused color.RGBA
 }
 
 func (d DefaultCheckBoxDrawer) Draw(cb *CheckBox) *ebiten.Image {
-	if cb.Checked() {
+	switch cb.State() {
+	case CheckBoxChecked:
 		cb.image.WritePixels(d.drawChecked(cb))
-	} else {
+	case CheckBoxIndeterminate:
+		cb.image.WritePixels(d.drawIndeterminate(cb))
+	default:
 		cb.image.WritePixels(d.drawUnchecked(cb))
 	}
 
 	return cb.image
 }
 
+// borderColor returns ColorFocused while the checkbox is focused, or Color otherwise.
+func (d DefaultCheckBoxDrawer) borderColor(cb *CheckBox) color.RGBA {
+	if cb.focused && d.ColorFocused.A > 0 {
+		return d.ColorFocused
+	}
+
+	return d.Color
+}
+
 func (d DefaultCheckBoxDrawer) isBorder(cb *CheckBox, rowId, colId int) bool {
 	return rowId == cb.firstPixelRowId || rowId == cb.lastPixelRowId || colId == cb.firstPixelColId || colId == cb.lastPixelColId
 }
@@ -32,15 +46,53 @@ func (d DefaultCheckBoxDrawer) isColored(cb *CheckBox, rowId, colId int) bool {
 	return colId > cb.secondPixelColId && colId < cb.penultimatePixelColId && rowId > cb.secondPixelRowId && rowId < cb.penultimatePixelRowId
 }
 
+// isDash returns whether the pixel is in the horizontal dash drawn inside an indeterminate checkbox.
+func (d DefaultCheckBoxDrawer) isDash(cb *CheckBox, rowId, colId int) bool {
+	middleRowId := (cb.firstPixelRowId + cb.lastPixelRowId) / 2
+	return colId > cb.secondPixelColId && colId < cb.penultimatePixelColId && (rowId == middleRowId || rowId == middleRowId+1)
+}
+
 func (d DefaultCheckBoxDrawer) drawUnchecked(cb *CheckBox) []byte {
 	arr := make([]byte, cb.component.pixelRows*cb.component.pixelCols)
 	backgroundColor := cb.container.GetBackgroundColor()
+	borderColor := d.borderColor(cb)
 
 	for rowId := cb.firstPixelRowId; rowId <= cb.lastPixelRowId; rowId++ {
 		rowNumber := cb.component.pixelCols * rowId
 
 		for colId := cb.firstPixelColId; colId <= cb.lastPixelColId; colId += 4 {
 			if d.isBorder(cb, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else {
+				arr[colId+rowNumber] = backgroundColor.R
+				arr[colId+1+rowNumber] = backgroundColor.G
+				arr[colId+2+rowNumber] = backgroundColor.B
+				arr[colId+3+rowNumber] = backgroundColor.A
+			}
+		}
+	}
+
+	return arr
+}
+
+func (d DefaultCheckBoxDrawer) drawChecked(cb *CheckBox) []byte {
+	arr := make([]byte, cb.component.pixelRows*cb.component.pixelCols)
+	backgroundColor := cb.container.GetBackgroundColor()
+	borderColor := d.borderColor(cb)
+
+	for rowId := cb.firstPixelRowId; rowId <= cb.lastPixelRowId; rowId++ {
+		rowNumber := cb.component.pixelCols * rowId
+
+		for colId := cb.firstPixelColId; colId <= cb.lastPixelColId; colId += 4 {
+			if d.isBorder(cb, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else if d.isColored(cb, rowId, colId) {
 				arr[colId+rowNumber] = d.Color.R
 				arr[colId+1+rowNumber] = d.Color.G
 				arr[colId+2+rowNumber] = d.Color.B
@@ -57,15 +109,21 @@ func (d DefaultCheckBoxDrawer) drawUnchecked(cb *CheckBox) []byte {
 	return arr
 }
 
-func (d DefaultCheckBoxDrawer) drawChecked(cb *CheckBox) []byte {
+func (d DefaultCheckBoxDrawer) drawIndeterminate(cb *CheckBox) []byte {
 	arr := make([]byte, cb.component.pixelRows*cb.component.pixelCols)
 	backgroundColor := cb.container.GetBackgroundColor()
+	borderColor := d.borderColor(cb)
 
 	for rowId := cb.firstPixelRowId; rowId <= cb.lastPixelRowId; rowId++ {
 		rowNumber := cb.component.pixelCols * rowId
 
 		for colId := cb.firstPixelColId; colId <= cb.lastPixelColId; colId += 4 {
-			if d.isBorder(cb, rowId, colId) || d.isColored(cb, rowId, colId) {
+			if d.isBorder(cb, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else if d.isDash(cb, rowId, colId) {
 				arr[colId+rowNumber] = d.Color.R
 				arr[colId+1+rowNumber] = d.Color.G
 				arr[colId+2+rowNumber] = d.Color.B
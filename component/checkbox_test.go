@@ -47,6 +47,25 @@ func TestCheckbox_Toggle(t *testing.T) {
 	is.Equal(cb.Checked(), true)
 }
 
+func TestCheckbox_PreventDefault(t *testing.T) {
+	is := is.New(t)
+
+	eventManager := event.NewManager()
+
+	cb := NewCheckBox(&CheckBoxOptions{})
+	cb.SetEventManager(eventManager)
+	remove := cb.AddMouseButtonReleasedHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+		args.PreventDefault()
+	})
+
+	leftMouseButtonClick(t, &cb.component)
+	is.Equal(cb.Checked(), false) // the toggle is blocked
+
+	remove()
+	leftMouseButtonClick(t, &cb.component)
+	is.Equal(cb.Checked(), true)
+}
+
 func TestCheckbox_SetChecked(t *testing.T) {
 	is := is.New(t)
 
@@ -78,3 +97,72 @@ func TestCheckbox_SetChecked(t *testing.T) {
 	is.Equal(cb.Checked(), false)
 	is.Equal(firedEventsCounter, 2)
 }
+
+func TestCheckbox_Indeterminate(t *testing.T) {
+	is := is.New(t)
+
+	eventManager := event.NewManager()
+
+	cb := NewCheckBox(&CheckBoxOptions{})
+	cb.SetEventManager(eventManager)
+
+	var toggledArgs *CheckBoxToggledEventArgs
+	cb.AddToggledHandler(func(args *CheckBoxToggledEventArgs) {
+		toggledArgs = args
+	})
+
+	cb.SetState(CheckBoxIndeterminate)
+	eventManager.HandleFired()
+	is.Equal(cb.State(), CheckBoxIndeterminate)
+	is.True(!cb.Checked())
+	is.Equal(toggledArgs.PreviousState, CheckBoxUnchecked)
+
+	// clicking an indeterminate checkbox checks it
+	leftMouseButtonClick(t, &cb.component)
+	is.Equal(cb.State(), CheckBoxChecked)
+	is.Equal(toggledArgs.State, CheckBoxChecked)
+	is.Equal(toggledArgs.PreviousState, CheckBoxIndeterminate)
+}
+
+func TestBindCheckBoxes(t *testing.T) {
+	is := is.New(t)
+
+	eventManager := event.NewManager()
+
+	parent := NewCheckBox(nil)
+	parent.SetEventManager(eventManager)
+
+	children := make([]*CheckBox, 3)
+	for i := range children {
+		children[i] = NewCheckBox(nil)
+		children[i].SetEventManager(eventManager)
+	}
+
+	children[0].Set(true)
+	unbind := BindCheckBoxes(parent, children...)
+	eventManager.HandleFired()
+	is.Equal(parent.State(), CheckBoxIndeterminate)
+
+	// toggling the parent sets all of the children
+	leftMouseButtonClick(t, &parent.component)
+	is.Equal(parent.State(), CheckBoxChecked)
+	for _, child := range children {
+		is.True(child.Checked())
+	}
+
+	leftMouseButtonClick(t, &children[1].component)
+	is.Equal(parent.State(), CheckBoxIndeterminate)
+	is.True(children[0].Checked()) // the other children are left as they are
+	is.True(children[2].Checked())
+
+	leftMouseButtonClick(t, &parent.component)
+	leftMouseButtonClick(t, &parent.component)
+	is.Equal(parent.State(), CheckBoxUnchecked)
+	for _, child := range children {
+		is.True(!child.Checked())
+	}
+
+	unbind()
+	leftMouseButtonClick(t, &children[1].component)
+	is.Equal(parent.State(), CheckBoxUnchecked)
+}
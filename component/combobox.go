@@ -0,0 +1,779 @@
+package component
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"time"
+	"unicode"
+
+	colorutils "github.com/fglo/chopstiqs/color"
+	"github.com/fglo/chopstiqs/event"
+	fontutils "github.com/fglo/chopstiqs/font"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// comboBoxTypeAheadTimeout is the time after which the text typed to jump to an item is forgotten.
+var comboBoxTypeAheadTimeout = time.Second
+
+const (
+	comboBoxDefaultMaxVisibleItems = 8
+	// comboBoxTextOffset is the distance between the combo box's and the list items' left edges and their texts
+	comboBoxTextOffset = 4
+)
+
+// ComboBox is a dropdown list of items. It shows the selected item and opens the list of the items on the gui's popup layer
+// when it's clicked or activated. While it's focused, the up and down arrows select the previous and next items
+// and typing jumps to the first item starting with the typed text.
+// An editable combo box shows a text input instead of the selected item, and typing in it filters the list to the items containing the text.
+type ComboBox struct {
+	component
+
+	items         []ComboBoxItem
+	selectedIndex int
+
+	editable bool
+
+	// button shows the selected item, or only the arrow if the combo box is editable
+	button    *Button
+	label     *Label
+	textInput *TextInput
+
+	textColor  color.RGBA
+	itemDrawer ButtonDrawer
+
+	list      *ScrollContainer
+	listItems []*Button
+	// shownItems are the indices of the items shown in the list, which are all of them unless they are filtered
+	shownItems []int
+	// highlighted is the index in shownItems of the list item highlighted with the keyboard or the mouse, or -1
+	highlighted int
+	// hoveredItem is the index in shownItems of the list item under the mouse cursor, or -1.
+	// The highlight follows the cursor only when it moves to another item, so it can be moved with the keyboard while the cursor rests on the list.
+	hoveredItem     int
+	open            bool
+	maxVisibleItems int
+
+	typeAhead      string
+	typeAheadTimer *timer.Handle
+
+	SelectionChangedEvent *event.Event[*ComboBoxSelectionChangedEventArgs]
+}
+
+// ComboBoxItem is an item of a combo box. The label is shown in the list and the value can be anything the item stands for.
+type ComboBoxItem struct {
+	Label string
+	Value any
+}
+
+type ComboBoxOptions struct {
+	Items []ComboBoxItem
+	// SelectedIndex is the index of the initially selected item. If not set, no item is selected.
+	SelectedIndex option.OptInt
+
+	// Width is the combo box's width. If not set, the combo box fits the widest of the items.
+	Width  option.OptInt
+	Height option.OptInt
+
+	// MaxVisibleItems is the number of items the list shows before it starts scrolling. If not set, it's 8.
+	MaxVisibleItems option.OptInt
+
+	// Editable replaces the selected item's label with a text input. Typing in it filters the list.
+	Editable bool
+
+	// TextColor is the color of the items' labels and the arrow.
+	TextColor color.Color
+
+	// Drawer draws the combo box's button and the list's items.
+	Drawer ButtonDrawer
+
+	Padding *Padding
+	Tooltip *Tooltip
+}
+
+type ComboBoxSelectionChangedEventArgs struct {
+	ComboBox *ComboBox
+	// Index is the index of the selected item, or -1 if the selection was cleared.
+	Index         int
+	PreviousIndex int
+	// Item is the selected item. It's empty if the selection was cleared.
+	Item ComboBoxItem
+}
+
+type ComboBoxSelectionChangedHandlerFunc func(args *ComboBoxSelectionChangedEventArgs)
+
+// NewComboBox creates a new combo box.
+func NewComboBox(opt *ComboBoxOptions) *ComboBox {
+	cb := &ComboBox{
+		SelectionChangedEvent: &event.Event[*ComboBoxSelectionChangedEventArgs]{},
+
+		selectedIndex:   -1,
+		highlighted:     -1,
+		hoveredItem:     -1,
+		maxVisibleItems: comboBoxDefaultMaxVisibleItems,
+
+		textColor: color.RGBA{50, 50, 50, 255},
+	}
+
+	width := 0
+	height := 15
+
+	if opt != nil {
+		cb.items = append(cb.items, opt.Items...)
+
+		if opt.SelectedIndex.IsSet() && opt.SelectedIndex.Val() >= 0 && opt.SelectedIndex.Val() < len(cb.items) {
+			cb.selectedIndex = opt.SelectedIndex.Val()
+		}
+
+		if opt.Width.IsSet() {
+			width = opt.Width.Val()
+		}
+
+		if opt.Height.IsSet() {
+			height = opt.Height.Val()
+		}
+
+		if opt.MaxVisibleItems.IsSet() && opt.MaxVisibleItems.Val() > 0 {
+			cb.maxVisibleItems = opt.MaxVisibleItems.Val()
+		}
+
+		cb.editable = opt.Editable
+
+		if opt.TextColor != nil {
+			cb.textColor = colorutils.ToRGBA(opt.TextColor)
+		}
+
+		cb.itemDrawer = opt.Drawer
+	}
+
+	if width <= 0 {
+		width = 40
+		for _, item := range cb.items {
+			width = max(width, fontutils.MeasureString(item.Label, fontutils.DefaultFontFace)+2*comboBoxTextOffset+height)
+		}
+	}
+
+	cb.SetDimensions(width, height)
+
+	cb.setUpComponent(opt)
+
+	cb.setUpParts()
+
+	cb.list = NewScrollContainer(&ScrollContainerOptions{
+		Layout: &VerticalListLayout{},
+		Width:  option.Int(cb.widthWithPadding),
+		Height: option.Int(height),
+	})
+	cb.list.SetBackgroundColor(color.RGBA{60, 60, 60, 255})
+
+	cb.updateText()
+
+	return cb
+}
+
+func (cb *ComboBox) setUpComponent(opt *ComboBoxOptions) {
+	var componentOptions ComponentOptions
+
+	if opt != nil {
+		componentOptions = ComponentOptions{
+			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
+		}
+	}
+
+	cb.component.setUpComponent(&componentOptions)
+	cb.focusable = true
+}
+
+// setUpParts creates the button opening the list and the label or the text input showing the selected item.
+func (cb *ComboBox) setUpParts() {
+	buttonWidth := cb.width
+	if cb.editable {
+		buttonWidth = cb.arrowWidth()
+	}
+
+	cb.button = NewButton(&ButtonOptions{Width: option.Int(buttonWidth), Height: option.Int(cb.height), Drawer: cb.itemDrawer})
+	cb.button.setContainer(cb)
+	cb.button.SetPosition(float64(cb.padding.Left+cb.width-buttonWidth), float64(cb.padding.Top))
+
+	cb.button.AddClickedHandler(func(args *ButtonClickedEventArgs) {
+		if cb.open {
+			cb.Close()
+		} else {
+			cb.Open()
+		}
+
+		if cb.editable {
+			cb.textInput.SetFocused(true)
+		}
+	})
+
+	if !cb.editable {
+		cb.label = NewLabel("", &LabelOptions{Color: cb.textColor})
+		return
+	}
+
+	cb.textInput = NewTextInput(&TextInputOptions{Width: option.Int(cb.width - buttonWidth), Height: option.Int(cb.height)})
+	cb.textInput.setContainer(cb)
+	cb.textInput.SetPosition(float64(cb.padding.Left), float64(cb.padding.Top))
+
+	cb.textInput.AddChangedHandler(func(args *TextInputChangedEventArgs) {
+		cb.filter(args.Text)
+	})
+}
+
+// arrowWidth returns the width of the area on the right side of the combo box the arrow is drawn in.
+func (cb *ComboBox) arrowWidth() int {
+	return cb.height
+}
+
+func (cb *ComboBox) AddSelectionChangedHandler(f ComboBoxSelectionChangedHandlerFunc) event.RemoveHandlerFunc {
+	return cb.SelectionChangedEvent.AddHandler(event.HandlerFunc[*ComboBoxSelectionChangedEventArgs](f))
+}
+
+// Items returns the combo box's items.
+func (cb *ComboBox) Items() []ComboBoxItem {
+	return cb.items
+}
+
+// SetItems replaces the combo box's items and clears the selection.
+func (cb *ComboBox) SetItems(items []ComboBoxItem) {
+	cb.Close()
+	cb.Select(-1)
+	cb.items = append([]ComboBoxItem(nil), items...)
+}
+
+// AddItem adds an item at the end of the combo box's list.
+func (cb *ComboBox) AddItem(item ComboBoxItem) {
+	cb.Close()
+	cb.items = append(cb.items, item)
+}
+
+// SelectedIndex returns the index of the selected item, or -1 if no item is selected.
+func (cb *ComboBox) SelectedIndex() int {
+	return cb.selectedIndex
+}
+
+// SelectedItem returns the selected item and whether any item is selected.
+func (cb *ComboBox) SelectedItem() (ComboBoxItem, bool) {
+	if cb.selectedIndex == -1 {
+		return ComboBoxItem{}, false
+	}
+
+	return cb.items[cb.selectedIndex], true
+}
+
+// Select selects the item at the index and fires the selection changed event. An index of -1 clears the selection.
+// Indices out of range are ignored.
+func (cb *ComboBox) Select(index int) {
+	if index < -1 || index >= len(cb.items) || index == cb.selectedIndex {
+		return
+	}
+
+	previousIndex := cb.selectedIndex
+	cb.selectedIndex = index
+	cb.updateText()
+
+	args := &ComboBoxSelectionChangedEventArgs{
+		ComboBox:      cb,
+		Index:         index,
+		PreviousIndex: previousIndex,
+	}
+
+	if index != -1 {
+		args.Item = cb.items[index]
+	}
+
+	event.Fire(cb.eventManager, cb.SelectionChangedEvent, args)
+}
+
+// SelectNext selects the item after the selected one.
+func (cb *ComboBox) SelectNext() {
+	if cb.selectedIndex < len(cb.items)-1 {
+		cb.Select(cb.selectedIndex + 1)
+	}
+}
+
+// SelectPrevious selects the item before the selected one.
+func (cb *ComboBox) SelectPrevious() {
+	if cb.selectedIndex > 0 {
+		cb.Select(cb.selectedIndex - 1)
+	}
+}
+
+// Increment selects the next item. It's called when the focused combo box is adjusted with the gamepad.
+func (cb *ComboBox) Increment() {
+	cb.SelectNext()
+}
+
+// Decrement selects the previous item. It's called when the focused combo box is adjusted with the gamepad.
+func (cb *ComboBox) Decrement() {
+	cb.SelectPrevious()
+}
+
+// Text returns the text shown by the combo box. It's the text typed in if the combo box is editable.
+func (cb *ComboBox) Text() string {
+	if cb.editable {
+		return cb.textInput.Value()
+	}
+
+	if item, ok := cb.SelectedItem(); ok {
+		return item.Label
+	}
+
+	return ""
+}
+
+// Editable returns whether the combo box has a text input filtering the list.
+func (cb *ComboBox) Editable() bool {
+	return cb.editable
+}
+
+// updateText shows the selected item's label.
+func (cb *ComboBox) updateText() {
+	var text string
+	if item, ok := cb.SelectedItem(); ok {
+		text = item.Label
+	}
+
+	if cb.editable {
+		cb.textInput.SetValue(text)
+		cb.textInput.End()
+	} else {
+		cb.label.SetText(text)
+	}
+}
+
+// IsOpen returns whether the combo box's list is open.
+func (cb *ComboBox) IsOpen() bool {
+	return cb.open
+}
+
+// Open opens the list of all items with the selected item highlighted.
+func (cb *ComboBox) Open() {
+	if cb.open || cb.disabled || len(cb.items) == 0 {
+		return
+	}
+
+	shown := make([]int, len(cb.items))
+	for i := range cb.items {
+		shown[i] = i
+	}
+
+	cb.showList(shown)
+	cb.highlight(cb.selectedIndex)
+}
+
+// Close closes the list.
+func (cb *ComboBox) Close() {
+	if !cb.open {
+		return
+	}
+
+	cb.open = false
+	cb.highlighted = -1
+	cb.hoveredItem = -1
+	cb.hidePopup(cb.list)
+}
+
+// Activate opens the list, or selects the highlighted item and closes the list if it's open.
+// It is called when the combo box is focused and activated with the keyboard.
+func (cb *ComboBox) Activate() {
+	if cb.disabled {
+		return
+	}
+
+	if !cb.open {
+		cb.Open()
+		return
+	}
+
+	if cb.highlighted != -1 {
+		cb.Select(cb.shownItems[cb.highlighted])
+	} else if cb.editable {
+		cb.selectText()
+	}
+
+	cb.Close()
+}
+
+// selectText selects the item labeled with the text typed in the editable combo box, ignoring the case.
+// If there is no such item, the selection is cleared and the text is kept.
+func (cb *ComboBox) selectText() {
+	text := cb.textInput.Value()
+
+	for i, item := range cb.items {
+		if strings.EqualFold(item.Label, text) {
+			cb.Select(i)
+			cb.updateText()
+			return
+		}
+	}
+
+	if cb.selectedIndex != -1 {
+		cb.Select(-1)
+		cb.textInput.SetValue(text)
+		cb.textInput.End()
+	}
+}
+
+// filter shows the items containing the text typed in the editable combo box, ignoring the case.
+func (cb *ComboBox) filter(text string) {
+	text = strings.ToLower(text)
+
+	var shown []int
+	for i, item := range cb.items {
+		if strings.Contains(strings.ToLower(item.Label), text) {
+			shown = append(shown, i)
+		}
+	}
+
+	if len(shown) == 0 || cb.disabled {
+		cb.Close()
+		return
+	}
+
+	cb.showList(shown)
+	cb.highlighted = -1
+}
+
+// showList fills the list with the items and opens it if it isn't open yet.
+func (cb *ComboBox) showList(shown []int) {
+	for _, item := range cb.listItems {
+		cb.list.content.RemoveComponent(item)
+	}
+
+	cb.shownItems = shown
+	cb.listItems = cb.listItems[:0]
+	cb.highlighted = -1
+	cb.hoveredItem = -1
+
+	scrolling := len(shown) > cb.maxVisibleItems
+	cb.list.verticalScrollbar = scrolling
+
+	itemWidth := cb.widthWithPadding
+	if scrolling {
+		itemWidth -= scrollbarThickness
+	}
+
+	for i, index := range shown {
+		item := cb.newListItem(i, cb.items[index].Label, itemWidth)
+		cb.list.AddComponent(item)
+		cb.listItems = append(cb.listItems, item)
+	}
+
+	cb.list.SetDimensions(cb.widthWithPadding, min(len(shown), cb.maxVisibleItems)*cb.height)
+	cb.list.ScrollTo(0, 0)
+
+	if !cb.open {
+		cb.open = true
+		cb.showPopup(cb.list)
+	}
+}
+
+// newListItem creates the button of the list item at the index in shownItems.
+func (cb *ComboBox) newListItem(i int, text string, width int) *Button {
+	var label *Label
+	if text != "" {
+		label = NewLabel(text, &LabelOptions{Color: cb.textColor, Padding: &Padding{Left: comboBoxTextOffset}})
+	}
+
+	item := NewButton(&ButtonOptions{Width: option.Int(width), Height: option.Int(cb.height), Drawer: cb.itemDrawer, Label: label})
+	item.SetTabIndex(-1)
+
+	if label != nil {
+		label.horizontalAlignment = option.AlignmentLeft
+		label.align()
+	}
+
+	item.AddCursorEnterHandler(func(args *ComponentCursorEnterEventArgs) {
+		if cb.hoveredItem != i {
+			cb.hoveredItem = i
+			cb.highlighted = i
+		}
+	})
+
+	item.AddCursorExitHandler(func(args *ComponentCursorExitEventArgs) {
+		if cb.hoveredItem == i {
+			cb.hoveredItem = -1
+		}
+	})
+
+	item.AddClickedHandler(func(args *ButtonClickedEventArgs) {
+		if !cb.open || i >= len(cb.shownItems) {
+			return
+		}
+
+		cb.Select(cb.shownItems[i])
+		cb.Close()
+		cb.SetFocused(true)
+	})
+
+	return item
+}
+
+// highlight highlights the item at the index in items if it's shown in the list, scrolling the list to it.
+func (cb *ComboBox) highlight(index int) {
+	for i, shown := range cb.shownItems {
+		if shown == index {
+			cb.highlightListItem(i)
+			return
+		}
+	}
+}
+
+// highlightListItem highlights the list item at the index in shownItems, limited to the list's bounds.
+func (cb *ComboBox) highlightListItem(i int) {
+	if len(cb.listItems) == 0 {
+		return
+	}
+
+	cb.highlighted = min(max(i, 0), len(cb.listItems)-1)
+	cb.list.ScrollIntoView(cb.listItems[cb.highlighted])
+}
+
+// typeAheadTo jumps to the first item starting with the text typed since the type-ahead timeout, ignoring the case.
+// The item is highlighted if the list is open, or selected otherwise.
+func (cb *ComboBox) typeAheadTo(chars []rune) {
+	for _, char := range chars {
+		// a space doesn't start the type-ahead, as it activates the combo box
+		if cb.typeAhead == "" && unicode.IsSpace(char) {
+			continue
+		}
+
+		cb.typeAhead += string(unicode.ToLower(char))
+	}
+
+	if cb.typeAhead == "" {
+		return
+	}
+
+	cb.typeAheadTimer.Cancel()
+	cb.typeAheadTimer = cb.eventManager.Scheduler().After(comboBoxTypeAheadTimeout, func() {
+		cb.typeAhead = ""
+	})
+
+	for i, item := range cb.items {
+		if strings.HasPrefix(strings.ToLower(item.Label), cb.typeAhead) {
+			if cb.open {
+				cb.highlight(i)
+			} else {
+				cb.Select(i)
+			}
+
+			return
+		}
+	}
+}
+
+// SetFocused sets the combo box's focused state. The list is closed when the combo box loses the focus.
+func (cb *ComboBox) SetFocused(focused bool) {
+	if !focused && cb.focused {
+		cb.Close()
+	}
+
+	cb.component.SetFocused(focused)
+
+	if cb.editable {
+		cb.textInput.SetFocused(focused)
+	}
+}
+
+// setContainer sets the component's container.
+func (cb *ComboBox) setContainer(container container) {
+	cb.component.setContainer(container)
+	cb.SetEventManager(container.EventManager())
+}
+
+// SetEventManager sets the combo box's, its parts' and its list's event managers.
+func (cb *ComboBox) SetEventManager(eventManager *event.Manager) {
+	cb.component.SetEventManager(eventManager)
+
+	if cb.button != nil {
+		cb.button.SetEventManager(eventManager)
+	}
+
+	if cb.textInput != nil {
+		cb.textInput.SetEventManager(eventManager)
+	}
+
+	if cb.list != nil {
+		cb.list.SetEventManager(eventManager)
+	}
+}
+
+// SetDisabled sets the combo box's and its parts' disabled states. Disabling the combo box closes its list.
+func (cb *ComboBox) SetDisabled(disabled bool) {
+	if disabled {
+		cb.Close()
+	}
+
+	cb.button.SetDisabled(disabled)
+	if cb.editable {
+		cb.textInput.SetDisabled(disabled)
+	}
+
+	cb.component.SetDisabled(disabled)
+}
+
+func (cb *ComboBox) SetBackgroundColor(color color.RGBA) {
+	cb.container.SetBackgroundColor(color)
+}
+
+func (cb *ComboBox) GetBackgroundColor() color.RGBA {
+	return cb.container.GetBackgroundColor()
+}
+
+// SetPosX sets the combo box's position X.
+func (cb *ComboBox) SetPosX(posX float64) {
+	cb.component.SetPosX(posX)
+	cb.recalculatePartsAbsPosition()
+}
+
+// SetPosY sets the combo box's position Y.
+func (cb *ComboBox) SetPosY(posY float64) {
+	cb.component.SetPosY(posY)
+	cb.recalculatePartsAbsPosition()
+}
+
+// SetPosition sets the combo box's position (x and y).
+func (cb *ComboBox) SetPosition(posX, posY float64) {
+	cb.component.SetPosition(posX, posY)
+	cb.recalculatePartsAbsPosition()
+}
+
+func (cb *ComboBox) RecalculateAbsPosition() {
+	cb.component.RecalculateAbsPosition()
+	cb.recalculatePartsAbsPosition()
+}
+
+func (cb *ComboBox) recalculatePartsAbsPosition() {
+	if cb.button != nil {
+		cb.button.RecalculateAbsPosition()
+	}
+
+	if cb.textInput != nil {
+		cb.textInput.RecalculateAbsPosition()
+	}
+}
+
+// FireEvents fires the combo box's and its parts' events and handles the keyboard input while it's focused.
+// The list is closed when a mouse button is pressed outside of the combo box and the list.
+func (cb *ComboBox) FireEvents(in input.InputSource) {
+	if cb.hidden || cb.disabled {
+		cb.Close()
+	}
+
+	cb.component.FireEvents(in)
+
+	if cb.editable {
+		cb.textInput.FireEvents(comboBoxTextInputSource{InputSource: in})
+	}
+
+	cb.button.FireEvents(in)
+
+	mouseButtonJustPressed := in.MouseButtonJustPressed(ebiten.MouseButtonLeft) || in.MouseButtonJustPressed(ebiten.MouseButtonRight)
+	if cb.open && mouseButtonJustPressed && !cb.lastUpdateCursorEntered && !cb.list.CursorOver() {
+		cb.Close()
+	}
+
+	if !cb.focused || cb.disabled || cb.hidden {
+		return
+	}
+
+	switch {
+	case in.KeyJustPressed(ebiten.KeyDown):
+		if cb.open {
+			cb.highlightListItem(cb.highlighted + 1)
+		} else {
+			cb.SelectNext()
+		}
+	case in.KeyJustPressed(ebiten.KeyUp):
+		if cb.open {
+			cb.highlightListItem(cb.highlighted - 1)
+		} else {
+			cb.SelectPrevious()
+		}
+	case in.KeyJustPressed(ebiten.KeyEscape):
+		cb.Close()
+	}
+
+	if !cb.editable {
+		if chars := in.InputChars(); len(chars) > 0 {
+			cb.typeAheadTo(chars)
+		}
+	}
+}
+
+// Draw draws the combo box's parts and the arrow, and highlights the list's item.
+func (cb *ComboBox) Draw() *ebiten.Image {
+	if cb.hidden {
+		return cb.image
+	}
+
+	if cb.editable {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(cb.textInput.Position())
+		cb.image.DrawImage(cb.textInput.Draw(), op)
+	}
+
+	// the button draws the combo box's focus
+	cb.button.focused = cb.focused
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(cb.button.Position())
+	cb.image.DrawImage(cb.button.Draw(), op)
+
+	if !cb.editable && cb.label.text != "" {
+		// the label is clipped, so a long one doesn't cover the arrow
+		textArea := cb.image.SubImage(image.Rect(cb.padding.Left, cb.padding.Top, cb.padding.Left+cb.width-cb.arrowWidth(), cb.padding.Top+cb.height)).(*ebiten.Image)
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(cb.padding.Left+comboBoxTextOffset), float64(cb.padding.Top+(cb.height-cb.label.heightWithPadding)/2))
+		textArea.DrawImage(cb.label.Draw(), op)
+	}
+
+	cb.drawArrow()
+
+	// the list is drawn on a layer above the combo box, after the items' hover state is updated by their events
+	for i, item := range cb.listItems {
+		item.hovering = i == cb.highlighted
+	}
+
+	cb.component.Draw()
+
+	return cb.image
+}
+
+// drawArrow draws the arrow pointing down in the middle of the arrow area.
+func (cb *ComboBox) drawArrow() {
+	const width, height = 5, 3
+
+	x := cb.padding.Left + cb.width - cb.arrowWidth() + (cb.arrowWidth()-width)/2
+	y := cb.padding.Top + (cb.height-height)/2
+
+	for row := 0; row < height; row++ {
+		cb.image.SubImage(image.Rect(x+row, y+row, x+width-row, y+row+1)).(*ebiten.Image).Fill(cb.textColor)
+	}
+}
+
+// handlesEscape returns whether Escape closes the combo box's list, so it doesn't close the dialog the combo box is in.
+func (cb *ComboBox) handlesEscape() bool {
+	return cb.open && cb.focused && !cb.disabled
+}
+
+// comboBoxTextInputSource hides the keys handled by the editable combo box from its text input.
+type comboBoxTextInputSource struct {
+	input.InputSource
+}
+
+func (in comboBoxTextInputSource) KeyPressed(key ebiten.Key) bool {
+	return key != ebiten.KeyEnter && key != ebiten.KeyEscape && in.InputSource.KeyPressed(key)
+}
+
+func (in comboBoxTextInputSource) KeyJustPressed(key ebiten.Key) bool {
+	return key != ebiten.KeyEnter && key != ebiten.KeyEscape && in.InputSource.KeyJustPressed(key)
+}
@@ -0,0 +1,168 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+var testComboBoxItems = []ComboBoxItem{
+	{Label: "easy", Value: 1},
+	{Label: "normal", Value: 2},
+	{Label: "hard", Value: 3},
+	{Label: "nightmare", Value: 4},
+}
+
+// newTestComboBox puts the combo box into a root container, which shows its list the way the gui does.
+func newTestComboBox(opt *ComboBoxOptions) (*Container, *ComboBox, *[]*ComponentPopupEventArgs) {
+	cb := NewComboBox(opt)
+	root := newTestRootContainer(cb)
+
+	var popupEvents []*ComponentPopupEventArgs
+	root.AddPopupHandler(func(args *ComponentPopupEventArgs) {
+		popupEvents = append(popupEvents, args)
+	})
+
+	return root, cb, &popupEvents
+}
+
+func TestComboBox_Select(t *testing.T) {
+	is := is.New(t)
+
+	root, cb, _ := newTestComboBox(&ComboBoxOptions{Items: testComboBoxItems, SelectedIndex: option.Int(1)})
+
+	is.Equal(cb.SelectedIndex(), 1)
+	is.Equal(cb.Text(), "normal")
+
+	var changedArgs *ComboBoxSelectionChangedEventArgs
+	cb.AddSelectionChangedHandler(func(args *ComboBoxSelectionChangedEventArgs) {
+		changedArgs = args
+	})
+
+	cb.Select(2)
+	root.eventManager.HandleFired()
+	is.Equal(changedArgs.Index, 2)
+	is.Equal(changedArgs.PreviousIndex, 1)
+	is.Equal(changedArgs.Item.Value, 3)
+
+	changedArgs = nil
+	cb.Select(len(testComboBoxItems)) // out of range
+	cb.Select(2)                      // already selected
+	root.eventManager.HandleFired()
+	is.True(changedArgs == nil)
+
+	cb.Select(-1)
+	root.eventManager.HandleFired()
+	is.Equal(changedArgs.Index, -1)
+	is.Equal(cb.Text(), "")
+
+	_, ok := cb.SelectedItem()
+	is.True(!ok)
+}
+
+func TestComboBox_keyboard(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, cb, _ := newTestComboBox(&ComboBoxOptions{Items: testComboBoxItems})
+	cb.SetFocused(true)
+
+	in.PressKey(ebiten.KeyDown)
+	fireRootEvents(t, root, in)
+	is.Equal(cb.SelectedIndex(), 0)
+
+	in.ReleaseAll()
+	in.PressKey(ebiten.KeyDown)
+	fireRootEvents(t, root, in)
+	is.Equal(cb.SelectedIndex(), 1)
+
+	in.ReleaseAll()
+	in.PressKey(ebiten.KeyUp)
+	fireRootEvents(t, root, in)
+	is.Equal(cb.SelectedIndex(), 0)
+
+	// typing jumps to the first item starting with the typed text
+	in.ReleaseAll()
+	in.TypeChars('N', 'i')
+	fireRootEvents(t, root, in)
+	is.Equal(cb.SelectedIndex(), 3)
+}
+
+func TestComboBox_Open(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, cb, popupEvents := newTestComboBox(&ComboBoxOptions{Items: testComboBoxItems, SelectedIndex: option.Int(1)})
+	cb.SetFocused(true)
+
+	cb.Activate()
+	root.eventManager.HandleFired()
+	is.True(cb.IsOpen())
+	is.Equal(len(*popupEvents), 1)
+	is.True((*popupEvents)[0].Shown)
+	is.Equal((*popupEvents)[0].Component, cb)
+	is.Equal(len(cb.list.Components()), len(testComboBoxItems))
+	is.Equal(cb.highlighted, 1) // the selected item is highlighted
+
+	// the arrows move the highlight without changing the selection
+	in.PressKey(ebiten.KeyDown)
+	fireRootEvents(t, root, in)
+	is.Equal(cb.highlighted, 2)
+	is.Equal(cb.SelectedIndex(), 1)
+
+	cb.Activate()
+	root.eventManager.HandleFired()
+	is.True(!cb.IsOpen())
+	is.Equal(cb.SelectedIndex(), 2)
+	is.Equal(len(*popupEvents), 2)
+	is.True(!(*popupEvents)[1].Shown)
+
+	// Escape closes the list
+	cb.Open()
+	in.ReleaseAll()
+	in.PressKey(ebiten.KeyEscape)
+	fireRootEvents(t, root, in)
+	is.True(!cb.IsOpen())
+	is.Equal(cb.SelectedIndex(), 2)
+}
+
+func TestComboBox_Open_scrolling(t *testing.T) {
+	is := is.New(t)
+
+	_, cb, _ := newTestComboBox(&ComboBoxOptions{Items: testComboBoxItems, MaxVisibleItems: option.Int(2)})
+
+	cb.Open()
+	is.Equal(cb.list.Height(), 2*cb.Height())
+
+	cb.highlightListItem(3)
+	_, scrollY := cb.list.ScrollPosition()
+	is.Equal(scrollY, 2*cb.Height()) // the highlighted item is scrolled into view
+}
+
+func TestComboBox_Editable(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, cb, _ := newTestComboBox(&ComboBoxOptions{Items: testComboBoxItems, Editable: true, Width: option.Int(80)})
+	cb.SetFocused(true)
+
+	// typing filters the list to the items containing the text
+	in.TypeChars('A', 'r')
+	fireRootEvents(t, root, in)
+	fireRootEvents(t, root, in) // the text input inserts the characters typed in the previous update
+	is.True(cb.IsOpen())
+	is.Equal(cb.Text(), "Ar")
+	is.Equal(cb.shownItems, []int{2, 3})
+
+	in.ReleaseAll()
+	in.PressKey(ebiten.KeyDown)
+	fireRootEvents(t, root, in)
+
+	cb.Activate()
+	root.eventManager.HandleFired()
+	is.True(!cb.IsOpen())
+	is.Equal(cb.SelectedIndex(), 2)
+	is.Equal(cb.Text(), "hard")
+}
@@ -3,21 +3,16 @@ package component
 import (
 	"image"
 	"image/color"
-	"regexp"
+	"math"
 
 	"github.com/fglo/chopstiqs/debug"
 	"github.com/fglo/chopstiqs/event"
 	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/fglo/chopstiqs/tween"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
-var wordSeparatorRegex *regexp.Regexp
-
-func init() {
-	var wordSeparator = `[^a-zA-Z0-9_]`
-	wordSeparatorRegex = regexp.MustCompile(wordSeparator)
-}
-
 // Component is an abstraction of a user interface component, like a button or checkbox.
 type Component interface {
 	// Draw draws the component to it's image during ebiten.Draw().
@@ -52,8 +47,18 @@ type Component interface {
 	Hidden() bool
 	// SetHidden sets the component's hidden state.
 	SetHidden(hidden bool)
-	// FireEvents fires the component's events.
-	FireEvents()
+	// Alpha returns the component's opacity, from 0 (transparent) to 1 (opaque).
+	Alpha() float64
+	// SetAlpha sets the component's opacity, from 0 (transparent) to 1 (opaque).
+	SetAlpha(alpha float64)
+	// Tooltip returns the component's tooltip.
+	Tooltip() *Tooltip
+	// SetTooltip sets the component's tooltip.
+	SetTooltip(tooltip *Tooltip)
+	// FireEvents fires the component's events based on the input state.
+	FireEvents(in input.InputSource)
+	// CursorOver returns whether the mouse cursor was over the component when its events were last fired.
+	CursorOver() bool
 	// SetWidth sets the component's width.
 	SetWidth(width int)
 	// SetHeight sets the component's height.
@@ -81,18 +86,36 @@ type Component interface {
 
 	Focused() bool
 	SetFocused(bool)
+	// Focusable returns whether the component can be focused with the keyboard.
+	Focusable() bool
+	// TabIndex returns the component's tab index.
+	TabIndex() int
+	// SetTabIndex sets the component's tab index.
+	// Components with a positive tab index are focused first, in ascending order, followed by the components
+	// with a zero tab index in layout order. Components with a negative tab index are skipped.
+	SetTabIndex(tabIndex int)
 
 	setContainer(container)
+	// parent returns the container the component was added to, or nil for the root container.
+	parent() container
+	// growsWithComponents returns whether the component grows to fit the components added to it.
+	growsWithComponents() bool
+	// base returns the component embedded in the component's type.
+	base() *component
+	fireMouseWheelEvent(wheelX, wheelY float64, cursorPosX, cursorPosY int)
 
 	EventManager() *event.Manager
 	SetEventManager(*event.Manager)
 
-	AddFocusedHandler(f ComponentFocusedHandlerFunc) Component
+	AddFocusedHandler(f ComponentFocusedHandlerFunc) event.RemoveHandlerFunc
 }
 
 // component is an abstraction of a user interface component, like a button or checkbox.
 type component struct {
 	container container
+	// self is the component embedding this one. It's set when the component is added to a container.
+	// Events of components that aren't part of the container hierarchy, like the parts of other components, don't propagate.
+	self Component
 
 	eventManager *event.Manager
 
@@ -102,6 +125,8 @@ type component struct {
 
 	disabled bool
 	hidden   bool
+	// alpha is applied when the component's container draws it
+	alpha float64
 
 	width             int
 	widthWithPadding  int
@@ -129,17 +154,29 @@ type component struct {
 	posX float64
 	posY float64
 
-	focused bool
+	focused   bool
+	focusable bool
+	tabIndex  int
 
 	lastUpdateMouseLeftButtonPressed  bool
 	lastUpdateMouseRightButtonPressed bool
 	lastUpdateCursorEntered           bool
 
-	MouseButtonPressedEvent  *event.Event
-	MouseButtonReleasedEvent *event.Event
-	CursorEnterEvent         *event.Event
-	CursorExitEvent          *event.Event
-	FocusedEvent             *event.Event
+	tooltip      *Tooltip
+	tooltipTimer *timer.Handle
+	tooltipShown bool
+	// tooltipSuppressed is set when a mouse button is pressed over the component, so the tooltip isn't shown again until the cursor leaves it
+	tooltipSuppressed bool
+
+	MouseButtonJustPressedEvent *event.Event[*ComponentMouseButtonJustPressedEventArgs]
+	MouseButtonPressedEvent     *event.Event[*ComponentMouseButtonPressedEventArgs]
+	MouseButtonReleasedEvent    *event.Event[*ComponentMouseButtonReleasedEventArgs]
+	CursorEnterEvent            *event.Event[*ComponentCursorEnterEventArgs]
+	CursorExitEvent             *event.Event[*ComponentCursorExitEventArgs]
+	FocusedEvent                *event.Event[*ComponentFocusedEventArgs]
+	MouseWheelEvent             *event.Event[*ComponentMouseWheelEventArgs]
+	TooltipEvent                *event.Event[*ComponentTooltipEventArgs]
+	PopupEvent                  *event.Event[*ComponentPopupEventArgs]
 }
 
 // ComponentOptions is a struct that holds component options.
@@ -147,17 +184,23 @@ type ComponentOptions struct {
 	Padding  *Padding
 	Disabled bool
 	Hidden   bool
+	Tooltip  *Tooltip
 }
 
 // SetupComponent sets up the component.
 func (c *component) setUpComponent(opt *ComponentOptions) {
-	c.MouseButtonPressedEvent = &event.Event{}
-	c.MouseButtonReleasedEvent = &event.Event{}
-	c.CursorEnterEvent = &event.Event{}
-	c.CursorExitEvent = &event.Event{}
-	c.FocusedEvent = &event.Event{}
+	c.MouseButtonJustPressedEvent = &event.Event[*ComponentMouseButtonJustPressedEventArgs]{}
+	c.MouseButtonPressedEvent = &event.Event[*ComponentMouseButtonPressedEventArgs]{}
+	c.MouseButtonReleasedEvent = &event.Event[*ComponentMouseButtonReleasedEventArgs]{}
+	c.CursorEnterEvent = &event.Event[*ComponentCursorEnterEventArgs]{}
+	c.CursorExitEvent = &event.Event[*ComponentCursorExitEventArgs]{}
+	c.FocusedEvent = &event.Event[*ComponentFocusedEventArgs]{}
+	c.MouseWheelEvent = &event.Event[*ComponentMouseWheelEventArgs]{}
+	c.TooltipEvent = &event.Event[*ComponentTooltipEventArgs]{}
+	c.PopupEvent = &event.Event[*ComponentPopupEventArgs]{}
 
 	c.padding = DefaultPadding
+	c.alpha = 1
 
 	if opt != nil {
 		if opt.Padding != nil {
@@ -166,8 +209,11 @@ func (c *component) setUpComponent(opt *ComponentOptions) {
 
 		c.disabled = opt.Disabled
 		c.hidden = opt.Hidden
+		c.tooltip = opt.Tooltip
 	}
 
+	c.setUpTooltip()
+
 	c.SetDimensions(c.width, c.height)
 }
 
@@ -180,6 +226,59 @@ func (c *component) setContainer(container container) {
 	c.SetEventManager(container.EventManager())
 }
 
+func (c *component) parent() container {
+	return c.container
+}
+
+// animator returns the animator of the root container the component is in, or nil if the root container has none.
+func (c *component) animator() *tween.Animator {
+	var root container
+	for container := c.container; container != nil; container = container.parent() {
+		root = container
+	}
+
+	if rootContainer, ok := root.(*Container); ok {
+		return rootContainer.animator
+	}
+
+	return nil
+}
+
+func (c *component) growsWithComponents() bool {
+	return true
+}
+
+func (c *component) base() *component {
+	return c
+}
+
+// target returns the component embedding this one, or the component itself if it's not known.
+func (c *component) target() Component {
+	if c.self != nil {
+		return c.self
+	}
+
+	return c
+}
+
+// dispatch fires the component's event selected by selectEvent. If the event bubbles and the component
+// is part of the container hierarchy, the event propagates through the component's containers.
+func dispatch[T any](c *component, selectEvent func(c *component) *event.Event[T], args T, bubbles bool) {
+	path := []event.Node[T]{{Target: c.target(), Event: selectEvent(c)}}
+
+	if bubbles && c.self != nil {
+		for container := c.container; container != nil; container = container.parent() {
+			path = append(path, event.Node[T]{Target: container, Event: selectEvent(container.base())})
+		}
+
+		for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+	}
+
+	event.Dispatch(c.eventManager, path, args)
+}
+
 func (c *component) EventManager() *event.Manager {
 	return c.eventManager
 }
@@ -396,7 +495,7 @@ func (c *component) recalculateWidth() {
 	c.setImage()
 	c.setRect()
 
-	if c.container != nil && c.container.Width() < c.widthWithPadding {
+	if c.container != nil && c.container.growsWithComponents() && c.container.Width() < c.widthWithPadding {
 		c.container.SetWidth(c.widthWithPadding)
 	}
 }
@@ -410,7 +509,7 @@ func (c *component) recalculateHeight() {
 	c.setImage()
 	c.setRect()
 
-	if c.container != nil && c.container.Height() < c.heightWithPadding {
+	if c.container != nil && c.container.growsWithComponents() && c.container.Height() < c.heightWithPadding {
 		c.container.SetHeight(c.heightWithPadding)
 	}
 }
@@ -428,7 +527,7 @@ func (c *component) recalculateDimensions() {
 	c.setImage()
 	c.setRect()
 
-	if c.container != nil {
+	if c.container != nil && c.container.growsWithComponents() {
 		containerWidth := c.container.Width()
 		if containerWidth < c.widthWithPadding {
 			containerWidth = c.widthWithPadding
@@ -450,13 +549,28 @@ func (c *component) Focused() bool {
 func (c *component) SetFocused(focused bool) {
 	if c.focused != focused {
 		c.focused = focused
-		c.eventManager.Fire(c.FocusedEvent, &ComponentFocusedEventArgs{
-			Component: c,
+		dispatch(c, focusedEvent, &ComponentFocusedEventArgs{
+			Component: c.target(),
 			Focused:   focused,
-		})
+		}, true)
 	}
 }
 
+// Focusable returns whether the component can be focused with the keyboard.
+func (c *component) Focusable() bool {
+	return c.focusable
+}
+
+// TabIndex returns the component's tab index.
+func (c *component) TabIndex() int {
+	return c.tabIndex
+}
+
+// SetTabIndex sets the component's tab index.
+func (c *component) SetTabIndex(tabIndex int) {
+	c.tabIndex = tabIndex
+}
+
 func (c *component) calcPixelColIds() {
 	c.firstPixelColId = c.padding.Left * 4
 	c.secondPixelColId = c.firstPixelColId + 4
@@ -501,6 +615,17 @@ func (c *component) SetHidden(hidden bool) {
 	c.hidden = hidden
 }
 
+// Alpha returns the component's opacity, from 0 (transparent) to 1 (opaque).
+func (c *component) Alpha() float64 {
+	return c.alpha
+}
+
+// SetAlpha sets the component's opacity, from 0 (transparent) to 1 (opaque).
+// Values outside of the range are clamped.
+func (c *component) SetAlpha(alpha float64) {
+	c.alpha = math.Max(0, math.Min(1, alpha))
+}
+
 // Position returns the component's position (x and y).
 func (c *component) Position() (float64, float64) {
 	return c.posX, c.posY
@@ -556,177 +681,289 @@ func (c *component) HeightWithPadding() int {
 	return c.heightWithPadding
 }
 
+// CursorOver returns whether the mouse cursor was over the component when its events were last fired.
+func (c *component) CursorOver() bool {
+	return c.lastUpdateCursorEntered
+}
+
 // FireEvents checks if the mouse cursor is inside the component and fires events accordingly.
-func (c *component) FireEvents() {
-	p := image.Point{input.CursorPosX, input.CursorPosY}
+func (c *component) FireEvents(in input.InputSource) {
+	cursorPosX, cursorPosY := in.CursorPosition()
+	p := image.Point{cursorPosX, cursorPosY}
 	mouseEntered := p.In(c.rect)
 
+	mouseLeftButtonPressed := in.MouseButtonPressed(ebiten.MouseButtonLeft)
+	mouseLeftButtonJustPressed := in.MouseButtonJustPressed(ebiten.MouseButtonLeft)
+	mouseRightButtonPressed := in.MouseButtonPressed(ebiten.MouseButtonRight)
+	mouseRightButtonJustPressed := in.MouseButtonJustPressed(ebiten.MouseButtonRight)
+
 	if mouseEntered {
 		c.lastUpdateCursorEntered = true
 
-		if !input.MouseLeftButtonPressed && !input.MouseRightButtonPressed {
-			c.eventManager.Fire(c.CursorEnterEvent, &ComponentCursorEnterEventArgs{
-				Component: c,
-			})
+		if !mouseLeftButtonPressed && !mouseRightButtonPressed {
+			dispatch(c, cursorEnterEvent, &ComponentCursorEnterEventArgs{
+				Component: c.target(),
+			}, false)
 		}
 
-		if input.MouseLeftButtonJustPressed {
+		if mouseLeftButtonJustPressed {
 			c.lastUpdateMouseLeftButtonPressed = true
 			c.SetFocused(true)
+			dispatch(c, mouseButtonJustPressedEvent, &ComponentMouseButtonJustPressedEventArgs{
+				Component: c.target(),
+				Button:    ebiten.MouseButtonLeft,
+			}, true)
 		}
 
-		if input.MouseLeftButtonPressed {
+		if mouseLeftButtonPressed {
 			if c.focused {
-				c.eventManager.Fire(c.MouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
-					Component: c,
-					Button:    ebiten.MouseButtonLeft,
-				})
+				dispatch(c, mouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
+					Component:  c.target(),
+					Button:     ebiten.MouseButtonLeft,
+					CursorPosX: cursorPosX,
+					CursorPosY: cursorPosY,
+				}, true)
 			}
 		}
 
-		if input.MouseRightButtonJustPressed {
+		if mouseRightButtonJustPressed {
 			c.lastUpdateMouseRightButtonPressed = true
 			c.SetFocused(true)
+			dispatch(c, mouseButtonJustPressedEvent, &ComponentMouseButtonJustPressedEventArgs{
+				Component: c.target(),
+				Button:    ebiten.MouseButtonRight,
+			}, true)
 		}
 
-		if input.MouseRightButtonPressed {
+		if mouseRightButtonPressed {
 			if c.focused {
-				c.eventManager.Fire(c.MouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
-					Component: c,
-					Button:    ebiten.MouseButtonRight,
-					Inside:    mouseEntered,
-				})
+				dispatch(c, mouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
+					Component:  c.target(),
+					Button:     ebiten.MouseButtonRight,
+					Inside:     mouseEntered,
+					CursorPosX: cursorPosX,
+					CursorPosY: cursorPosY,
+				}, true)
 			}
 		}
 	} else {
 		c.lastUpdateCursorEntered = false
 
-		c.eventManager.Fire(c.CursorExitEvent, &ComponentCursorExitEventArgs{
-			Component: c,
-		})
-
-		if input.MouseLeftButtonPressed && c.lastUpdateMouseLeftButtonPressed {
-			c.eventManager.Fire(c.MouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
-				Component: c,
-				Button:    ebiten.MouseButtonLeft,
-				Inside:    mouseEntered,
-			})
+		dispatch(c, cursorExitEvent, &ComponentCursorExitEventArgs{
+			Component: c.target(),
+		}, false)
+
+		if mouseLeftButtonPressed && c.lastUpdateMouseLeftButtonPressed {
+			dispatch(c, mouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
+				Component:  c.target(),
+				Button:     ebiten.MouseButtonLeft,
+				Inside:     mouseEntered,
+				CursorPosX: cursorPosX,
+				CursorPosY: cursorPosY,
+			}, true)
 		}
 
-		if input.MouseLeftButtonJustPressed || input.MouseRightButtonJustPressed {
+		if mouseLeftButtonJustPressed || mouseRightButtonJustPressed {
 			c.SetFocused(false)
 		}
 	}
 
-	if !input.MouseLeftButtonPressed && c.lastUpdateMouseLeftButtonPressed {
+	if !mouseLeftButtonPressed && c.lastUpdateMouseLeftButtonPressed {
 		c.lastUpdateMouseLeftButtonPressed = false
-		c.eventManager.Fire(c.MouseButtonReleasedEvent, &ComponentMouseButtonReleasedEventArgs{
-			Component: c,
-			Inside:    mouseEntered,
-			Button:    ebiten.MouseButtonLeft,
-		})
+		dispatch(c, mouseButtonReleasedEvent, &ComponentMouseButtonReleasedEventArgs{
+			Component:  c.target(),
+			Inside:     mouseEntered,
+			Button:     ebiten.MouseButtonLeft,
+			CursorPosX: cursorPosX,
+			CursorPosY: cursorPosY,
+		}, true)
 	}
 
-	if !input.MouseRightButtonPressed && c.lastUpdateMouseRightButtonPressed {
+	if !mouseRightButtonPressed && c.lastUpdateMouseRightButtonPressed {
 		c.lastUpdateMouseRightButtonPressed = false
-		c.eventManager.Fire(c.MouseButtonReleasedEvent, &ComponentMouseButtonReleasedEventArgs{
-			Component: c,
-			Inside:    mouseEntered,
-			Button:    ebiten.MouseButtonRight,
-		})
+		dispatch(c, mouseButtonReleasedEvent, &ComponentMouseButtonReleasedEventArgs{
+			Component:  c.target(),
+			Inside:     mouseEntered,
+			Button:     ebiten.MouseButtonRight,
+			CursorPosX: cursorPosX,
+			CursorPosY: cursorPosY,
+		}, true)
 	}
 }
 
+// fireMouseWheelEvent fires the component's mouse wheel event for the wheel scrolled over the component.
+func (c *component) fireMouseWheelEvent(wheelX, wheelY float64, cursorPosX, cursorPosY int) {
+	dispatch(c, mouseWheelEvent, &ComponentMouseWheelEventArgs{
+		Component:  c.target(),
+		WheelX:     wheelX,
+		WheelY:     wheelY,
+		CursorPosX: cursorPosX,
+		CursorPosY: cursorPosY,
+	}, true)
+}
+
+func mouseButtonJustPressedEvent(c *component) *event.Event[*ComponentMouseButtonJustPressedEventArgs] {
+	return c.MouseButtonJustPressedEvent
+}
+
+func mouseButtonPressedEvent(c *component) *event.Event[*ComponentMouseButtonPressedEventArgs] {
+	return c.MouseButtonPressedEvent
+}
+
+func mouseButtonReleasedEvent(c *component) *event.Event[*ComponentMouseButtonReleasedEventArgs] {
+	return c.MouseButtonReleasedEvent
+}
+
+func cursorEnterEvent(c *component) *event.Event[*ComponentCursorEnterEventArgs] {
+	return c.CursorEnterEvent
+}
+
+func cursorExitEvent(c *component) *event.Event[*ComponentCursorExitEventArgs] {
+	return c.CursorExitEvent
+}
+
+func focusedEvent(c *component) *event.Event[*ComponentFocusedEventArgs] {
+	return c.FocusedEvent
+}
+
+func mouseWheelEvent(c *component) *event.Event[*ComponentMouseWheelEventArgs] {
+	return c.MouseWheelEvent
+}
+
+// EventArgs is the base of the component events' arguments. Mouse button, mouse wheel and focus events
+// are propagated through the container hierarchy: capture handlers are called from the root container down to
+// the target component, then the target's handlers, and then handlers of its containers up to the root.
+type EventArgs struct {
+	event.Args
+}
+
+// Target returns the component the event was fired for.
+func (a *EventArgs) Target() Component {
+	target, _ := a.Args.Target().(Component)
+	return target
+}
+
+// CurrentTarget returns the component whose handlers are currently called.
+func (a *EventArgs) CurrentTarget() Component {
+	currentTarget, _ := a.Args.CurrentTarget().(Component)
+	return currentTarget
+}
+
 // ComponentMouseButtonJustPressedHandlerFunc is a function that handles mouse button press events.
 type ComponentMouseButtonJustPressedHandlerFunc func(args *ComponentMouseButtonJustPressedEventArgs) //nolint:golint
 // ComponentMouseButtonPressedEventArgs are the arguments for mouse button press events.
 type ComponentMouseButtonJustPressedEventArgs struct { //nolint:golint
+	EventArgs
+
 	Component Component
 	Button    ebiten.MouseButton
 }
 
-func (c *component) AddMouseButtonJustPressedHandler(f ComponentMouseButtonJustPressedHandlerFunc) Component {
-	c.MouseButtonPressedEvent.AddHandler(func(args interface{}) {
-		f(args.(*ComponentMouseButtonJustPressedEventArgs))
-	})
-
-	return c
+func (c *component) AddMouseButtonJustPressedHandler(f ComponentMouseButtonJustPressedHandlerFunc) event.RemoveHandlerFunc {
+	return c.MouseButtonJustPressedEvent.AddHandler(event.HandlerFunc[*ComponentMouseButtonJustPressedEventArgs](f))
 }
 
 // ComponentMouseButtonPressedHandlerFunc is a function that handles mouse button press events.
 type ComponentMouseButtonPressedHandlerFunc func(args *ComponentMouseButtonPressedEventArgs) //nolint:golint
 // ComponentMouseButtonPressedEventArgs are the arguments for mouse button press events.
 type ComponentMouseButtonPressedEventArgs struct { //nolint:golint
-	Component Component
-	Button    ebiten.MouseButton
-	Inside    bool
+	EventArgs
+
+	Component  Component
+	Button     ebiten.MouseButton
+	Inside     bool
+	CursorPosX int
+	CursorPosY int
 }
 
-func (c *component) AddMouseButtonPressedHandler(f ComponentMouseButtonPressedHandlerFunc) Component {
-	c.MouseButtonPressedEvent.AddHandler(func(args interface{}) {
-		f(args.(*ComponentMouseButtonPressedEventArgs))
-	})
+func (c *component) AddMouseButtonPressedHandler(f ComponentMouseButtonPressedHandlerFunc) event.RemoveHandlerFunc {
+	return c.MouseButtonPressedEvent.AddHandler(event.HandlerFunc[*ComponentMouseButtonPressedEventArgs](f))
+}
 
-	return c
+// AddMouseButtonPressedCaptureHandler registers a handler called before the handlers of the pressed component and its containers.
+func (c *component) AddMouseButtonPressedCaptureHandler(f ComponentMouseButtonPressedHandlerFunc) event.RemoveHandlerFunc {
+	return c.MouseButtonPressedEvent.AddCaptureHandler(event.HandlerFunc[*ComponentMouseButtonPressedEventArgs](f))
 }
 
 // ComponentMouseButtonReleasedHandlerFunc is a function that handles mouse button release events.
 type ComponentMouseButtonReleasedHandlerFunc func(args *ComponentMouseButtonReleasedEventArgs) //nolint:golint
 // ComponentMouseButtonReleasedEventArgs are the arguments for mouse button release events.
 type ComponentMouseButtonReleasedEventArgs struct { //nolint:golint
-	Component Component
-	Button    ebiten.MouseButton
-	Inside    bool
+	EventArgs
+
+	Component  Component
+	Button     ebiten.MouseButton
+	Inside     bool
+	CursorPosX int
+	CursorPosY int
 }
 
-func (c *component) AddMouseButtonReleasedHandler(f ComponentMouseButtonReleasedHandlerFunc) Component {
-	c.MouseButtonReleasedEvent.AddHandler(func(args interface{}) {
-		f(args.(*ComponentMouseButtonReleasedEventArgs))
-	})
+func (c *component) AddMouseButtonReleasedHandler(f ComponentMouseButtonReleasedHandlerFunc) event.RemoveHandlerFunc {
+	return c.MouseButtonReleasedEvent.AddHandler(event.HandlerFunc[*ComponentMouseButtonReleasedEventArgs](f))
+}
 
-	return c
+// AddMouseButtonReleasedCaptureHandler registers a handler called before the handlers of the released component and its containers.
+func (c *component) AddMouseButtonReleasedCaptureHandler(f ComponentMouseButtonReleasedHandlerFunc) event.RemoveHandlerFunc {
+	return c.MouseButtonReleasedEvent.AddCaptureHandler(event.HandlerFunc[*ComponentMouseButtonReleasedEventArgs](f))
 }
 
 // ComponentCursorEnterHandlerFunc is a function that handles cursor enter events.
 type ComponentCursorEnterHandlerFunc func(args *ComponentCursorEnterEventArgs) //nolint:golint
 // ComponentCursorEnterEventArgs are the arguments for cursor enter events.
 type ComponentCursorEnterEventArgs struct { //nolint:golint
+	EventArgs
+
 	Component Component
 }
 
-func (c *component) AddCursorEnterHandler(f ComponentCursorEnterHandlerFunc) Component {
-	c.CursorEnterEvent.AddHandler(func(args interface{}) {
-		f(args.(*ComponentCursorEnterEventArgs))
-	})
-
-	return c
+func (c *component) AddCursorEnterHandler(f ComponentCursorEnterHandlerFunc) event.RemoveHandlerFunc {
+	return c.CursorEnterEvent.AddHandler(event.HandlerFunc[*ComponentCursorEnterEventArgs](f))
 }
 
 // ComponentCursorExitHandlerFunc is a function that handles cursor exit events.
 type ComponentCursorExitHandlerFunc func(args *ComponentCursorExitEventArgs) //nolint:golint
 // ComponentCursorExitEventArgs are the arguments for cursor exit events.
 type ComponentCursorExitEventArgs struct { //nolint:golint
+	EventArgs
+
 	Component Component
 }
 
-func (c *component) AddCursorExitHandler(f ComponentCursorExitHandlerFunc) Component {
-	c.CursorExitEvent.AddHandler(func(args interface{}) {
-		f(args.(*ComponentCursorExitEventArgs))
-	})
+func (c *component) AddCursorExitHandler(f ComponentCursorExitHandlerFunc) event.RemoveHandlerFunc {
+	return c.CursorExitEvent.AddHandler(event.HandlerFunc[*ComponentCursorExitEventArgs](f))
+}
+
+// ComponentMouseWheelHandlerFunc is a function that handles mouse wheel events.
+type ComponentMouseWheelHandlerFunc func(args *ComponentMouseWheelEventArgs) //nolint:golint
+// ComponentMouseWheelEventArgs are the arguments for mouse wheel events.
+// The event is fired for the topmost component under the cursor and propagates through its containers.
+type ComponentMouseWheelEventArgs struct { //nolint:golint
+	EventArgs
 
-	return c
+	Component  Component
+	WheelX     float64
+	WheelY     float64
+	CursorPosX int
+	CursorPosY int
+}
+
+func (c *component) AddMouseWheelHandler(f ComponentMouseWheelHandlerFunc) event.RemoveHandlerFunc {
+	return c.MouseWheelEvent.AddHandler(event.HandlerFunc[*ComponentMouseWheelEventArgs](f))
+}
+
+// AddMouseWheelCaptureHandler registers a handler called before the handlers of the component under the cursor and its containers.
+func (c *component) AddMouseWheelCaptureHandler(f ComponentMouseWheelHandlerFunc) event.RemoveHandlerFunc {
+	return c.MouseWheelEvent.AddCaptureHandler(event.HandlerFunc[*ComponentMouseWheelEventArgs](f))
 }
 
 type ComponentFocusedHandlerFunc func(args *ComponentFocusedEventArgs) //nolint:golint
 type ComponentFocusedEventArgs struct {
+	EventArgs
+
 	Component Component
 	Focused   bool
 }
 
-func (c *component) AddFocusedHandler(f ComponentFocusedHandlerFunc) Component {
-	c.FocusedEvent.AddHandler(func(args interface{}) {
-		f(args.(*ComponentFocusedEventArgs))
-	})
-
-	return c
+func (c *component) AddFocusedHandler(f ComponentFocusedHandlerFunc) event.RemoveHandlerFunc {
+	return c.FocusedEvent.AddHandler(event.HandlerFunc[*ComponentFocusedEventArgs](f))
 }
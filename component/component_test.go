@@ -3,10 +3,20 @@ package component
 import (
 	"testing"
 
+	"github.com/fglo/chopstiqs/event"
 	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/timer"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
+// newTestEventManager returns an event manager whose scheduler runs on a fake clock.
+func newTestEventManager() *event.Manager {
+	eventManager := event.NewManager()
+	eventManager.SetScheduler(timer.NewScheduler(timer.NewFakeClock()))
+
+	return eventManager
+}
+
 func leftMouseButtonClick(t *testing.T, c *component) {
 	t.Helper()
 
@@ -17,7 +27,7 @@ func leftMouseButtonClick(t *testing.T, c *component) {
 func leftMouseButtonPress(t *testing.T, c *component) {
 	t.Helper()
 
-	c.eventManager.Fire(c.MouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
+	event.Fire(c.eventManager, c.MouseButtonPressedEvent, &ComponentMouseButtonPressedEventArgs{
 		Component: c,
 		Button:    ebiten.MouseButtonLeft,
 	})
@@ -28,7 +38,7 @@ func leftMouseButtonPress(t *testing.T, c *component) {
 func leftMouseButtonRelease(t *testing.T, c *component) {
 	t.Helper()
 
-	c.eventManager.Fire(c.MouseButtonReleasedEvent, &ComponentMouseButtonReleasedEventArgs{
+	event.Fire(c.eventManager, c.MouseButtonReleasedEvent, &ComponentMouseButtonReleasedEventArgs{
 		Component: c,
 		Button:    ebiten.MouseButtonLeft,
 		Inside:    true,
@@ -37,14 +47,25 @@ func leftMouseButtonRelease(t *testing.T, c *component) {
 	c.eventManager.HandleFired()
 }
 
-func keyPress(t *testing.T, key ebiten.Key) {
+func newTestInputSource(t *testing.T) *input.FakeInputSource {
+	t.Helper()
+
+	in := input.NewFakeInputSource()
+	in.Update()
+
+	return in
+}
+
+func keyPress(t *testing.T, in *input.FakeInputSource, key ebiten.Key) {
 	t.Helper()
 
-	input.KeyPressed[key] = true
+	in.PressKey(key)
+	in.Update()
 }
 
-func keyRelease(t *testing.T, key ebiten.Key) {
+func keyRelease(t *testing.T, in *input.FakeInputSource, key ebiten.Key) {
 	t.Helper()
 
-	input.KeyPressed[key] = false
+	in.ReleaseKey(key)
+	in.Update()
 }
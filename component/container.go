@@ -1,9 +1,13 @@
 package component
 
 import (
+	"image"
 	imgColor "image/color"
 
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
 	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/tween"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -14,7 +18,12 @@ type container interface {
 	// GetBackgroundColor gets the container's background color
 	GetBackgroundColor() imgColor.RGBA
 	// FireEvents fires the container's components deferred events
-	FireEvents()
+	FireEvents(in input.InputSource)
+}
+
+// hitTester is implemented by containers that can find the component under the mouse cursor.
+type hitTester interface {
+	componentAt(p image.Point) Component
 }
 
 type Container struct {
@@ -27,6 +36,9 @@ type Container struct {
 
 	lastComponentPosX int
 	lastComponentPosY int
+
+	// animator plays the animations of the components in the container's tree. It's set on the root containers by the gui.
+	animator *tween.Animator
 }
 
 type ContainerOptions struct {
@@ -43,6 +55,7 @@ func NewContainer(opt *ContainerOptions) *Container {
 	c := &Container{
 		components: make([]Component, 0),
 	}
+	c.self = c
 
 	c.SetDimensions(1, 1)
 
@@ -90,6 +103,15 @@ func (c *Container) setContainer(container container) {
 		c.layout.Rearrange(c)
 	}
 	c.component.setContainer(container)
+	c.SetEventManager(container.EventManager())
+}
+
+// SetEventManager sets the container's and its components event managers.
+func (c *Container) SetEventManager(eventManager *event.Manager) {
+	c.component.SetEventManager(eventManager)
+	for _, component := range c.components {
+		component.SetEventManager(eventManager)
+	}
 }
 
 // SetDisabled sets the container's and its component disabled states
@@ -106,13 +128,30 @@ func (c *Container) AddComponent(component Component) {
 	if c.layout != nil {
 		c.layout.Arrange(c, component)
 	}
+	component.base().self = component
 	component.setContainer(c)
-	component.AddFocusedHandler(func(args *ComponentFocusedEventArgs) {
-		c.eventManager.Fire(c.FocusedEvent, &ComponentFocusedEventArgs{
-			Focused:   component.Focused(),
-			Component: component,
-		})
-	})
+}
+
+// RemoveComponent removes a component from the container and rearranges the remaining ones.
+func (c *Container) RemoveComponent(component Component) {
+	for i, child := range c.components {
+		if child == component {
+			c.components = append(c.components[:i:i], c.components[i+1:]...)
+			component.base().container = nil
+			component.RecalculateAbsPosition()
+
+			if c.layout != nil {
+				c.layout.Rearrange(c)
+			}
+
+			return
+		}
+	}
+}
+
+// Components returns the container's components in the order they were added.
+func (c *Container) Components() []Component {
+	return c.components
 }
 
 // AddComponents adds components to the container
@@ -168,6 +207,12 @@ func (c *Container) RecalculateAbsPosition() {
 	}
 }
 
+// SetAnimator sets the animator the components of the root container's tree play their animations with, like the toggle switches' knobs.
+// The gui sets its animator on the root container and the layers' containers.
+func (c *Container) SetAnimator(animator *tween.Animator) {
+	c.animator = animator
+}
+
 // SetBackgroundColor sets the container's background color
 func (c *Container) SetBackgroundColor(color imgColor.RGBA) {
 	c.backgroundColor = color
@@ -178,13 +223,67 @@ func (c *Container) GetBackgroundColor() imgColor.RGBA {
 	return c.backgroundColor
 }
 
-// FireEvents fires the container's components deferred events
-func (c *Container) FireEvents() {
+// FireEvents fires the container's components deferred events.
+// The container itself is hit by the mouse cursor only if its background is visible.
+// The root container also routes the mouse wheel to the topmost component under the cursor.
+func (c *Container) FireEvents(in input.InputSource) {
+	cursorPosX, cursorPosY := in.CursorPosition()
+	c.lastUpdateCursorEntered = c.backgroundColor.A > 0 && image.Pt(cursorPosX, cursorPosY).In(c.rect)
+
 	for _, component := range c.components {
-		component.FireEvents()
+		component.FireEvents(in)
+	}
+
+	if c.container == nil {
+		c.fireMouseWheelEvents(in)
 	}
 }
 
+// fireMouseWheelEvents fires the mouse wheel event for the topmost component under the cursor.
+func (c *Container) fireMouseWheelEvents(in input.InputSource) {
+	wheelX, wheelY := in.Wheel()
+	if wheelX == 0 && wheelY == 0 {
+		return
+	}
+
+	cursorPosX, cursorPosY := in.CursorPosition()
+
+	target := c.componentAt(image.Pt(cursorPosX, cursorPosY))
+	if target == nil {
+		return
+	}
+
+	target.fireMouseWheelEvent(wheelX, wheelY, cursorPosX, cursorPosY)
+}
+
+// componentAt returns the topmost visible component at the point, or nil if the point is outside of the container.
+// Components added later are drawn on top, so they are checked first.
+func (c *Container) componentAt(p image.Point) Component {
+	if c.hidden || !p.In(c.rect) {
+		return nil
+	}
+
+	for i := len(c.components) - 1; i >= 0; i-- {
+		component := c.components[i]
+
+		if nested, ok := component.(hitTester); ok {
+			if hit := nested.componentAt(p); hit != nil {
+				return hit
+			}
+
+			continue
+		}
+
+		x, y := component.AbsPosition()
+		w, h := component.Dimensions()
+		if !component.Hidden() && p.In(image.Rect(int(x), int(y), int(x)+w, int(y)+h)) {
+			return component
+		}
+	}
+
+	return c
+}
+
 // Draw draws the container's components, executes deferred events and returns the image.
 func (c *Container) Draw() *ebiten.Image {
 	c.image.Fill(c.backgroundColor)
@@ -193,6 +292,7 @@ func (c *Container) Draw() *ebiten.Image {
 		if !component.Hidden() {
 			op := &ebiten.DrawImageOptions{}
 			op.GeoM.Translate(component.Position())
+			op.ColorScale.ScaleAlpha(float32(component.Alpha()))
 			c.image.DrawImage(component.Draw(), op)
 		}
 	}
@@ -0,0 +1,209 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func newTestRootContainer(components ...Component) *Container {
+	root := NewContainer(&ContainerOptions{
+		Layout: &VerticalListLayout{RowGap: 5},
+	})
+	root.SetEventManager(event.NewManager())
+	root.AddComponents(components...)
+
+	return root
+}
+
+// fireRootEvents updates the input and fires the events of the root container's tree.
+func fireRootEvents(t *testing.T, root *Container, in *input.FakeInputSource) {
+	t.Helper()
+
+	in.Update()
+	root.FireEvents(in)
+	root.eventManager.HandleFired()
+}
+
+func scrollWheel(t *testing.T, root *Container, in *input.FakeInputSource, x, y int, wheelX, wheelY float64) {
+	t.Helper()
+
+	in.MoveCursor(x, y)
+	in.ScrollWheel(wheelX, wheelY)
+	in.Update()
+
+	root.FireEvents(in)
+	root.eventManager.HandleFired()
+}
+
+func TestContainer_MouseWheelEvent(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	button := NewButton(nil)
+	other := NewButton(nil)
+	nested := NewContainer(&ContainerOptions{
+		Layout: &VerticalListLayout{},
+	})
+	root := newTestRootContainer(nested, other)
+	nested.AddComponent(button)
+
+	var fired []Component
+	var targets []Component
+	record := func(c Component) ComponentMouseWheelHandlerFunc {
+		return func(args *ComponentMouseWheelEventArgs) {
+			fired = append(fired, c)
+			targets = append(targets, args.Target())
+			is.Equal(args.WheelY, 1.0)
+		}
+	}
+
+	button.AddMouseWheelHandler(record(button))
+	other.AddMouseWheelHandler(record(other))
+	nested.AddMouseWheelHandler(record(nested))
+	root.AddMouseWheelHandler(record(root))
+
+	x, y := button.AbsPosition()
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 1)
+
+	is.Equal(fired, []Component{button, nested, root}) // bubbles from the target to the root
+	is.Equal(targets, []Component{button, button, button})
+
+	fired = nil
+	in.Update()
+	root.FireEvents(in)
+	root.eventManager.HandleFired()
+	is.Equal(len(fired), 0) // not fired without scrolling
+}
+
+func TestContainer_MouseWheelEvent_topmostComponent(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	bottom := NewButton(nil)
+	top := NewButton(nil)
+	// without a layout the components overlap at the container's origin
+	root := NewContainer(&ContainerOptions{
+		Width:  option.Int(100),
+		Height: option.Int(100),
+	})
+	root.SetEventManager(event.NewManager())
+	root.AddComponents(bottom, top)
+
+	var target Component
+	root.AddMouseWheelHandler(func(args *ComponentMouseWheelEventArgs) {
+		target = args.Target()
+	})
+
+	scrollWheel(t, root, in, 1, 1, 0, 1)
+	is.Equal(target, top)
+
+	top.SetHidden(true)
+	scrollWheel(t, root, in, 1, 1, 0, 1)
+	is.Equal(target, bottom)
+}
+
+func TestContainer_MouseButtonEvents_propagation(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	button := NewButton(nil)
+	panel := NewContainer(&ContainerOptions{
+		Layout: &VerticalListLayout{},
+	})
+	root := newTestRootContainer(panel)
+	panel.AddComponent(button)
+
+	clicked := 0
+	button.AddClickedHandler(func(args *ButtonClickedEventArgs) {
+		clicked++
+	})
+
+	var bubbled []Component
+	root.AddMouseButtonReleasedHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+		is.Equal(args.CurrentTarget(), root)
+		bubbled = append(bubbled, args.Target())
+	})
+
+	click := func() {
+		x, y := button.AbsPosition()
+		in.MoveCursor(int(x)+1, int(y)+1)
+		in.PressMouseButton(ebiten.MouseButtonLeft)
+		in.Update()
+		root.FireEvents(in)
+		root.eventManager.HandleFired()
+
+		in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+		in.Update()
+		root.FireEvents(in)
+		root.eventManager.HandleFired()
+	}
+
+	click()
+	is.Equal(clicked, 1)
+	is.Equal(bubbled, []Component{button}) // the release bubbles up to the root
+
+	// the panel intercepts the clicks of its components
+	intercepting := true
+	panel.AddMouseButtonPressedCaptureHandler(func(args *ComponentMouseButtonPressedEventArgs) {
+		if intercepting {
+			is.Equal(args.Target(), button)
+			args.StopPropagation()
+			args.PreventDefault()
+		}
+	})
+
+	click()
+	is.Equal(clicked, 1)
+
+	intercepting = false
+	click()
+	is.Equal(clicked, 2)
+}
+
+func TestContainer_MouseButtonJustPressedEvent(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	button := NewButton(nil)
+	root := newTestRootContainer(button)
+
+	var pressed []ebiten.MouseButton
+	root.AddMouseButtonJustPressedHandler(func(args *ComponentMouseButtonJustPressedEventArgs) {
+		is.Equal(args.Target(), button)
+		pressed = append(pressed, args.Button)
+	})
+
+	x, y := button.AbsPosition()
+	in.MoveCursor(int(x)+1, int(y)+1)
+	in.PressMouseButton(ebiten.MouseButtonRight)
+	in.Update()
+	root.FireEvents(in)
+	root.eventManager.HandleFired()
+
+	in.Update()
+	root.FireEvents(in)
+	root.eventManager.HandleFired()
+
+	is.Equal(pressed, []ebiten.MouseButton{ebiten.MouseButtonRight}) // fired only when the button goes down
+}
+
+func TestContainer_RemoveComponent(t *testing.T) {
+	is := is.New(t)
+
+	first := NewButton(nil)
+	second := NewButton(nil)
+	root := newTestRootContainer(first, second)
+
+	is.True(second.PosY() > 0)
+
+	root.RemoveComponent(first)
+	is.Equal(len(root.Components()), 1)
+	is.Equal(root.Components()[0], second)
+	is.Equal(second.PosY(), 0.0) // the remaining components are rearranged
+	is.True(first.parent() == nil)
+}
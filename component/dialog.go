@@ -0,0 +1,369 @@
+package component
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// Dialog is a modal window. It covers its whole parent container, dims everything below it
+// and aligns its content panel within it. While it's open, the components below it don't receive any input.
+// It's closed with Escape. While another dialog is open on top of it, it doesn't receive any input either.
+type Dialog struct {
+	component
+
+	panel *Container
+
+	dimColor color.RGBA
+
+	horizontalAlignment option.HorizontalAlignment
+	verticalAlignment   option.VerticalAlignment
+
+	ClosedEvent *event.Event[*DialogClosedEventArgs]
+}
+
+type DialogOptions struct {
+	// Layout is the layout of the dialog's panel.
+	Layout Layout
+
+	Width  option.OptInt
+	Height option.OptInt
+
+	Padding *Padding
+
+	// HorizontalAlignment is the alignment of the panel within the dialog. If not set, the panel is centered.
+	HorizontalAlignment *option.HorizontalAlignment
+	// VerticalAlignment is the alignment of the panel within the dialog. If not set, the panel is centered.
+	VerticalAlignment *option.VerticalAlignment
+
+	// DimColor is the color drawn over the components below the dialog. If not set, they are darkened by half.
+	DimColor *color.RGBA
+}
+
+type DialogClosedEventArgs struct {
+	Dialog *Dialog
+	// Canceled is set when the dialog was closed with Escape or Cancel.
+	Canceled bool
+}
+
+type DialogClosedHandlerFunc func(args *DialogClosedEventArgs)
+
+// NewDialog creates a new hidden dialog with an empty panel. It's shown when it's opened by the gui.
+func NewDialog(opt *DialogOptions) *Dialog {
+	d := &Dialog{
+		ClosedEvent: &event.Event[*DialogClosedEventArgs]{},
+
+		dimColor: color.RGBA{0, 0, 0, 128},
+
+		horizontalAlignment: option.AlignmentCenteredHorizontally,
+		verticalAlignment:   option.AlignmentCenteredVertically,
+	}
+	d.self = d
+
+	var panelOptions ContainerOptions
+
+	if opt != nil {
+		if opt.HorizontalAlignment != nil {
+			d.horizontalAlignment = *opt.HorizontalAlignment
+		}
+
+		if opt.VerticalAlignment != nil {
+			d.verticalAlignment = *opt.VerticalAlignment
+		}
+
+		if opt.DimColor != nil {
+			d.dimColor = *opt.DimColor
+		}
+
+		panelOptions = ContainerOptions{
+			Layout:  opt.Layout,
+			Width:   opt.Width,
+			Height:  opt.Height,
+			Padding: opt.Padding,
+		}
+	}
+
+	d.SetDimensions(1, 1)
+
+	d.setUpComponent()
+
+	d.panel = NewContainer(&panelOptions)
+	d.panel.SetBackgroundColor(color.RGBA{60, 60, 60, 255})
+	d.panel.setContainer(d)
+
+	return d
+}
+
+func (d *Dialog) setUpComponent() {
+	// the dialog covers its parent exactly, the padding is the panel's
+	d.component.setUpComponent(&ComponentOptions{
+		Padding: &Padding{},
+		Hidden:  true,
+	})
+}
+
+// Panel returns the dialog's panel container.
+func (d *Dialog) Panel() *Container {
+	return d.panel
+}
+
+// Components returns the components of the dialog's panel.
+func (d *Dialog) Components() []Component {
+	return d.panel.Components()
+}
+
+// AddComponent adds a component to the dialog's panel.
+func (d *Dialog) AddComponent(component Component) {
+	d.panel.AddComponent(component)
+}
+
+// AddComponents adds components to the dialog's panel.
+func (d *Dialog) AddComponents(components ...Component) {
+	d.panel.AddComponents(components...)
+}
+
+func (d *Dialog) AddClosedHandler(f DialogClosedHandlerFunc) event.RemoveHandlerFunc {
+	return d.ClosedEvent.AddHandler(event.HandlerFunc[*DialogClosedEventArgs](f))
+}
+
+// Close hides the dialog and fires the closed event.
+func (d *Dialog) Close() {
+	d.close(false)
+}
+
+// Cancel hides the dialog and fires the closed event marked as canceled, like Escape does.
+func (d *Dialog) Cancel() {
+	d.close(true)
+}
+
+func (d *Dialog) close(canceled bool) {
+	if d.hidden {
+		return
+	}
+
+	d.hidden = true
+
+	event.Fire(d.eventManager, d.ClosedEvent, &DialogClosedEventArgs{
+		Dialog:   d,
+		Canceled: canceled,
+	})
+}
+
+// SetBackgroundColor sets the background color of the dialog's panel.
+func (d *Dialog) SetBackgroundColor(color color.RGBA) {
+	d.panel.SetBackgroundColor(color)
+}
+
+// GetBackgroundColor gets the background color of the dialog's panel.
+func (d *Dialog) GetBackgroundColor() color.RGBA {
+	return d.panel.GetBackgroundColor()
+}
+
+// SetDimColor sets the color drawn over the components below the dialog.
+func (d *Dialog) SetDimColor(color color.RGBA) {
+	d.dimColor = color
+}
+
+// setContainer sets the component's container.
+func (d *Dialog) setContainer(container container) {
+	d.component.setContainer(container)
+	d.SetEventManager(container.EventManager())
+	d.fitToParent()
+}
+
+// growsWithComponents returns false, as the dialog covers its parent container instead.
+func (d *Dialog) growsWithComponents() bool {
+	return false
+}
+
+// SetEventManager sets the dialog's and its panel's event managers.
+func (d *Dialog) SetEventManager(eventManager *event.Manager) {
+	d.component.SetEventManager(eventManager)
+	if d.panel != nil {
+		d.panel.SetEventManager(eventManager)
+	}
+}
+
+// SetDisabled sets the dialog's and its panel's disabled states.
+func (d *Dialog) SetDisabled(disabled bool) {
+	d.panel.SetDisabled(disabled)
+	d.component.SetDisabled(disabled)
+}
+
+// SetPosX sets the dialog's position X.
+func (d *Dialog) SetPosX(posX float64) {
+	d.component.SetPosX(posX)
+	d.recalculatePanelAbsPosition()
+}
+
+// SetPosY sets the dialog's position Y.
+func (d *Dialog) SetPosY(posY float64) {
+	d.component.SetPosY(posY)
+	d.recalculatePanelAbsPosition()
+}
+
+// SetPosition sets the dialog's position (x and y).
+func (d *Dialog) SetPosition(posX, posY float64) {
+	d.component.SetPosition(posX, posY)
+	d.recalculatePanelAbsPosition()
+}
+
+func (d *Dialog) RecalculateAbsPosition() {
+	d.component.RecalculateAbsPosition()
+	d.recalculatePanelAbsPosition()
+}
+
+func (d *Dialog) recalculatePanelAbsPosition() {
+	if d.panel != nil {
+		d.panel.RecalculateAbsPosition()
+	}
+}
+
+// fitToParent resizes the dialog to its parent container and aligns the panel within it.
+func (d *Dialog) fitToParent() {
+	if d.container != nil {
+		width, height := d.container.Dimensions()
+		if width != d.width || height != d.height {
+			d.SetDimensions(width, height)
+		}
+	}
+
+	if d.panel == nil {
+		return
+	}
+
+	var x int
+	switch d.horizontalAlignment {
+	case option.AlignmentLeft:
+		x = 0
+	case option.AlignmentCenteredHorizontally:
+		x = (d.width - d.panel.WidthWithPadding()) / 2
+	case option.AlignmentRight:
+		x = d.width - d.panel.WidthWithPadding()
+	}
+
+	var y int
+	switch d.verticalAlignment {
+	case option.AlignmentTop:
+		y = 0
+	case option.AlignmentCenteredVertically:
+		y = (d.height - d.panel.HeightWithPadding()) / 2
+	case option.AlignmentBottom:
+		y = d.height - d.panel.HeightWithPadding()
+	}
+
+	if x != int(d.panel.PosX()) || y != int(d.panel.PosY()) {
+		d.panel.SetPosition(float64(x), float64(y))
+	}
+}
+
+// componentAt returns the topmost visible component of the panel at the point,
+// or the dialog itself if the point is outside of the panel, as the dialog covers everything below it.
+func (d *Dialog) componentAt(p image.Point) Component {
+	if d.hidden || !p.In(d.rect) {
+		return nil
+	}
+
+	if hit := d.panel.componentAt(p); hit != nil {
+		return hit
+	}
+
+	return d
+}
+
+// FireEvents fires the panel's events and closes the dialog on Escape, unless one of the panel's components handles it,
+// like a combo box closing its list. A dialog covered by another open dialog doesn't receive any input.
+func (d *Dialog) FireEvents(in input.InputSource) {
+	if d.hidden {
+		return
+	}
+
+	d.fitToParent()
+
+	if d.covered() {
+		in = input.BlockedInputSource{InputSource: in}
+	}
+
+	cursorPosX, cursorPosY := in.CursorPosition()
+	d.lastUpdateCursorEntered = image.Pt(cursorPosX, cursorPosY).In(d.rect)
+
+	// the components handle Escape while firing their events, so it's checked before they change their state
+	escapeHandled := escapeHandledBy(d.panel)
+
+	d.panel.FireEvents(in)
+
+	if in.KeyJustPressed(ebiten.KeyEscape) && !escapeHandled {
+		d.Cancel()
+	}
+}
+
+// covered returns whether an open dialog was added to the dialog's container after the dialog.
+func (d *Dialog) covered() bool {
+	parent, ok := d.container.(*Container)
+	if !ok {
+		return false
+	}
+
+	for i := len(parent.components) - 1; i >= 0; i-- {
+		if parent.components[i] == Component(d) {
+			return false
+		}
+
+		if other, ok := parent.components[i].(*Dialog); ok && !other.hidden {
+			return true
+		}
+	}
+
+	return false
+}
+
+// escapeHandler is implemented by the components that handle Escape themselves, like a combo box closing its list.
+type escapeHandler interface {
+	// handlesEscape returns whether the component handles Escape pressed now.
+	handlesEscape() bool
+}
+
+// escapeHandledBy returns whether the component or one of its visible descendants handles Escape pressed now.
+func escapeHandledBy(c Component) bool {
+	if c.Hidden() {
+		return false
+	}
+
+	if h, ok := c.(escapeHandler); ok && h.handlesEscape() {
+		return true
+	}
+
+	if container, ok := c.(interface{ Components() []Component }); ok {
+		for _, child := range container.Components() {
+			if escapeHandledBy(child) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Draw dims the dialog's area and draws the panel.
+func (d *Dialog) Draw() *ebiten.Image {
+	if d.hidden {
+		return d.image
+	}
+
+	d.fitToParent()
+
+	d.image.Fill(d.dimColor)
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(d.panel.Position())
+	op.ColorScale.ScaleAlpha(float32(d.panel.Alpha()))
+	d.image.DrawImage(d.panel.Draw(), op)
+
+	d.component.Draw()
+
+	return d.image
+}
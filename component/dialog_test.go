@@ -0,0 +1,55 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestDialog_alignment(t *testing.T) {
+	is := is.New(t)
+
+	right := option.AlignmentRight
+	top := option.AlignmentTop
+	d := NewDialog(&DialogOptions{
+		Width:               option.Int(40),
+		Height:              option.Int(20),
+		HorizontalAlignment: &right,
+		VerticalAlignment:   &top,
+	})
+
+	root := NewContainer(&ContainerOptions{Width: option.Int(200), Height: option.Int(100)})
+	root.AddComponent(d)
+
+	is.Equal(d.Width(), 200) // the dialog covers its parent
+	is.Equal(d.Height(), 100)
+
+	x, y := d.Panel().Position()
+	is.Equal(x, 160.0)
+	is.Equal(y, 0.0)
+}
+
+func TestDialog_Escape(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	d := NewDialog(nil)
+	root := newTestRootContainer(d)
+	d.SetHidden(false)
+
+	var closedArgs *DialogClosedEventArgs
+	d.AddClosedHandler(func(args *DialogClosedEventArgs) {
+		closedArgs = args
+	})
+
+	in.PressKey(ebiten.KeyEscape)
+	in.Update()
+	root.FireEvents(in)
+	root.eventManager.HandleFired()
+
+	is.True(d.Hidden())
+	is.True(closedArgs != nil)
+	is.True(closedArgs.Canceled)
+}
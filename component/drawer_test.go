@@ -0,0 +1,512 @@
+package component
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/snapshot"
+)
+
+func TestMain(m *testing.M) {
+	snapshot.Main(m)
+}
+
+// newSnapshotComponent puts the component into a container, so it can be drawn with the container's background.
+func newSnapshotComponent(t *testing.T, c Component) {
+	t.Helper()
+
+	container := NewContainer(nil)
+	container.SetEventManager(event.NewManager())
+	container.SetBackgroundColor(color.RGBA{40, 40, 40, 255})
+	container.AddComponent(c)
+}
+
+func TestButton_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(b *Button)
+	}{
+		{
+			name:  "idle",
+			setUp: func(b *Button) {},
+		},
+		{
+			name:  "hovered",
+			setUp: func(b *Button) { b.hovering = true },
+		},
+		{
+			name:  "pressed",
+			setUp: func(b *Button) { b.pressed = true },
+		},
+		{
+			name:  "disabled",
+			setUp: func(b *Button) { b.SetDisabled(true) },
+		},
+		{
+			name:  "focused",
+			setUp: func(b *Button) { b.SetFocused(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewButton(&ButtonOptions{
+				Label: NewLabel("button", &LabelOptions{Color: color.RGBA{50, 50, 50, 255}}),
+			})
+			newSnapshotComponent(t, b)
+
+			tt.setUp(b)
+
+			snapshot.Assert(t, b.Draw(), "button_"+tt.name)
+		})
+	}
+}
+
+func TestCheckBox_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(cb *CheckBox)
+	}{
+		{
+			name:  "unchecked",
+			setUp: func(cb *CheckBox) {},
+		},
+		{
+			name:  "checked",
+			setUp: func(cb *CheckBox) { cb.Set(true) },
+		},
+		{
+			name:  "indeterminate",
+			setUp: func(cb *CheckBox) { cb.SetState(CheckBoxIndeterminate) },
+		},
+		{
+			name:  "hovered",
+			setUp: func(cb *CheckBox) { cb.lastUpdateCursorEntered = true },
+		},
+		{
+			name: "pressed",
+			setUp: func(cb *CheckBox) {
+				cb.lastUpdateCursorEntered = true
+				cb.lastUpdateMouseLeftButtonPressed = true
+			},
+		},
+		{
+			name:  "disabled",
+			setUp: func(cb *CheckBox) { cb.SetDisabled(true) },
+		},
+		{
+			name:  "focused",
+			setUp: func(cb *CheckBox) { cb.SetFocused(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := NewCheckBox(&CheckBoxOptions{
+				Label: NewLabel("checkbox", nil),
+			})
+			newSnapshotComponent(t, cb)
+
+			tt.setUp(cb)
+
+			snapshot.Assert(t, cb.Draw(), "checkbox_"+tt.name)
+		})
+	}
+}
+
+func TestRadioButton_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(rb *RadioButton)
+	}{
+		{
+			name:  "unselected",
+			setUp: func(rb *RadioButton) {},
+		},
+		{
+			name:  "selected",
+			setUp: func(rb *RadioButton) { rb.Select() },
+		},
+		{
+			name:  "focused",
+			setUp: func(rb *RadioButton) { rb.SetFocused(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := NewRadioButton(&RadioButtonOptions{
+				Label: NewLabel("radio", nil),
+			})
+			newSnapshotComponent(t, rb)
+
+			tt.setUp(rb)
+
+			snapshot.Assert(t, rb.Draw(), "radiobutton_"+tt.name)
+		})
+	}
+}
+
+func TestToggleSwitch_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(sw *ToggleSwitch)
+	}{
+		{
+			name:  "unchecked",
+			setUp: func(sw *ToggleSwitch) {},
+		},
+		{
+			name:  "checked",
+			setUp: func(sw *ToggleSwitch) { sw.Set(true) },
+		},
+		{
+			name: "moving",
+			setUp: func(sw *ToggleSwitch) {
+				sw.checked = true
+				sw.knobPosition = 0.5
+			},
+		},
+		{
+			name:  "disabled",
+			setUp: func(sw *ToggleSwitch) { sw.SetDisabled(true) },
+		},
+		{
+			name:  "focused",
+			setUp: func(sw *ToggleSwitch) { sw.SetFocused(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sw := NewToggleSwitch(&ToggleSwitchOptions{
+				Label:             NewLabel("switch", nil),
+				AnimationDuration: -1,
+			})
+			newSnapshotComponent(t, sw)
+
+			tt.setUp(sw)
+
+			snapshot.Assert(t, sw.Draw(), "toggleswitch_"+tt.name)
+		})
+	}
+}
+
+func TestToggleButton_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(tb *ToggleButton)
+	}{
+		{
+			name:  "unchecked",
+			setUp: func(tb *ToggleButton) {},
+		},
+		{
+			name:  "checked",
+			setUp: func(tb *ToggleButton) { tb.Set(true) },
+		},
+		{
+			name:  "focused",
+			setUp: func(tb *ToggleButton) { tb.SetFocused(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := NewToggleButton(&ToggleButtonOptions{
+				ButtonOptions: ButtonOptions{
+					Label: NewLabel("toggle", &LabelOptions{Color: color.RGBA{50, 50, 50, 255}}),
+				},
+			})
+			newSnapshotComponent(t, tb)
+
+			tt.setUp(tb)
+
+			snapshot.Assert(t, tb.Draw(), "togglebutton_"+tt.name)
+		})
+	}
+}
+
+func TestSlider_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(s *Slider)
+	}{
+		{
+			name:  "idle",
+			setUp: func(s *Slider) {},
+		},
+		{
+			name:  "hovered",
+			setUp: func(s *Slider) { s.hovering = true },
+		},
+		{
+			name:  "pressed",
+			setUp: func(s *Slider) { s.pressed = true },
+		},
+		{
+			name:  "disabled",
+			setUp: func(s *Slider) { s.SetDisabled(true) },
+		},
+		{
+			name:  "focused",
+			setUp: func(s *Slider) { s.SetFocused(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSlider(&SliderOptions{
+				Min:          option.Float(0),
+				Max:          option.Float(10),
+				Step:         option.Float(1),
+				DefaultValue: option.Float(3),
+			})
+			newSnapshotComponent(t, s)
+
+			tt.setUp(s)
+
+			snapshot.Assert(t, s.Draw(), "slider_"+tt.name)
+		})
+	}
+}
+
+func TestComboBox_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(cb *ComboBox)
+	}{
+		{
+			name:  "idle",
+			setUp: func(cb *ComboBox) {},
+		},
+		{
+			name:  "focused",
+			setUp: func(cb *ComboBox) { cb.SetFocused(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := NewComboBox(&ComboBoxOptions{
+				Items:         []ComboBoxItem{{Label: "first"}, {Label: "second"}},
+				SelectedIndex: option.Int(0),
+				Width:         option.Int(60),
+			})
+			newSnapshotComponent(t, cb)
+
+			tt.setUp(cb)
+
+			snapshot.Assert(t, cb.Draw(), "combobox_"+tt.name)
+		})
+	}
+}
+
+func TestProgressBar_Snapshot(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  *ProgressBarOptions
+	}{
+		{
+			name: "horizontal",
+			opt:  &ProgressBarOptions{Value: option.Float(40), Width: option.Int(60)},
+		},
+		{
+			name: "vertical",
+			opt:  &ProgressBarOptions{Value: option.Float(40), Vertical: true},
+		},
+		{
+			name: "label",
+			opt: &ProgressBarOptions{
+				Value: option.Float(40),
+				Width: option.Int(60),
+				Label: NewLabel("", &LabelOptions{Color: color.RGBA{120, 120, 120, 255}}),
+			},
+		},
+		{
+			name: "indeterminate",
+			opt:  &ProgressBarOptions{Indeterminate: true, Width: option.Int(60)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb := NewProgressBar(tt.opt)
+			newSnapshotComponent(t, pb)
+			pb.indeterminateOffset = 0.5
+
+			snapshot.Assert(t, pb.Draw(), "progressbar_"+tt.name)
+		})
+	}
+}
+
+func TestTextInput_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(ti *TextInput)
+	}{
+		{
+			name:  "idle",
+			setUp: func(ti *TextInput) {},
+		},
+		{
+			name:  "hovered",
+			setUp: func(ti *TextInput) { ti.hovering = true },
+		},
+		{
+			name: "pressed",
+			setUp: func(ti *TextInput) {
+				ti.hovering = true
+				ti.pressed = true
+			},
+		},
+		{
+			name:  "disabled",
+			setUp: func(ti *TextInput) { ti.SetDisabled(true) },
+		},
+		{
+			name:  "focused",
+			setUp: func(ti *TextInput) { ti.SetFocused(true) },
+		},
+		{
+			name: "selected",
+			setUp: func(ti *TextInput) {
+				ti.SetFocused(true)
+				ti.SelectAll()
+			},
+		},
+		{
+			name:  "masked",
+			setUp: func(ti *TextInput) { ti.SetMasked(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := NewTextInput(&TextInputOptions{Width: option.Int(60)})
+			newSnapshotComponent(t, ti)
+			ti.SetValue("text")
+
+			tt.setUp(ti)
+
+			snapshot.Assert(t, ti.Draw(), "textinput_"+tt.name)
+		})
+	}
+}
+
+func TestTextArea_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(ta *TextArea)
+	}{
+		{
+			name:  "idle",
+			setUp: func(ta *TextArea) {},
+		},
+		{
+			name:  "focused",
+			setUp: func(ta *TextArea) { ta.SetFocused(true) },
+		},
+		{
+			name: "selected",
+			setUp: func(ta *TextArea) {
+				ta.SetFocused(true)
+				ta.cursorPosition = 2
+				ta.selecting = true
+				ta.selectingFrom = 2
+				ta.CursorDown()
+				ta.CursorDown()
+			},
+		},
+		{
+			name: "scrolled",
+			setUp: func(ta *TextArea) {
+				ta.SetFocused(true)
+				ta.End()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ta := NewTextArea(&TextAreaOptions{Width: option.Int(60), Height: option.Int(37)})
+			newSnapshotComponent(t, ta)
+			ta.SetValue("some text\n\nwrapped at spaces")
+
+			tt.setUp(ta)
+
+			snapshot.Assert(t, ta.Draw(), "textarea_"+tt.name)
+		})
+	}
+}
+
+func TestLabel_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(l *Label)
+	}{
+		{
+			name:  "idle",
+			setUp: func(l *Label) {},
+		},
+		{
+			name:  "inverted",
+			setUp: func(l *Label) { l.Inverted = true },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLabel("label", nil)
+			newSnapshotComponent(t, l)
+
+			tt.setUp(l)
+
+			snapshot.Assert(t, l.Draw(), "label_"+tt.name)
+		})
+	}
+}
+
+func TestScrollContainer_Snapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		setUp func(sc *ScrollContainer)
+	}{
+		{
+			name:  "idle",
+			setUp: func(sc *ScrollContainer) {},
+		},
+		{
+			name:  "scrolled",
+			setUp: func(sc *ScrollContainer) { sc.ScrollTo(20, 30) },
+		},
+		{
+			name:  "disabled",
+			setUp: func(sc *ScrollContainer) { sc.SetDisabled(true) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := NewScrollContainer(&ScrollContainerOptions{
+				Layout:              &VerticalListLayout{RowGap: 5},
+				Width:               option.Int(60),
+				Height:              option.Int(50),
+				VerticalScrollbar:   true,
+				HorizontalScrollbar: true,
+			})
+			sc.SetBackgroundColor(color.RGBA{40, 40, 40, 255})
+			newSnapshotComponent(t, sc)
+
+			for i := 0; i < 4; i++ {
+				sc.AddComponent(NewButton(&ButtonOptions{Width: option.Int(70)}))
+			}
+
+			tt.setUp(sc)
+
+			snapshot.Assert(t, sc.Draw(), "scrollcontainer_"+tt.name)
+		})
+	}
+}
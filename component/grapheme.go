@@ -0,0 +1,114 @@
+package component
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const zeroWidthJoiner = '\u200d'
+
+// graphemeBoundaries returns the byte offsets at which the grapheme clusters of the text start, followed by the length of the text.
+// A grapheme cluster is what the user sees as a single character, so the cursor never stops inside one.
+//
+// It approximates the extended grapheme clusters of Unicode Standard Annex #29: combining marks, variation selectors,
+// emoji modifiers, tags and the zero width joiner stay with the character before them, pictographs joined with the zero width joiner
+// form a single cluster, regional indicators are paired into flags and CR LF is a single line break.
+func graphemeBoundaries(text string) []int {
+	boundaries := make([]int, 0, utf8.RuneCountInString(text)+1)
+
+	previous := utf8.RuneError
+	// regionalIndicators is the number of regional indicators in a row before the current rune
+	regionalIndicators := 0
+	// pictographic is set when the runes before the current one are a pictograph followed only by extending runes and joiners
+	pictographic := false
+
+	for i, r := range text {
+		if i == 0 || !continuesGrapheme(previous, r, regionalIndicators, pictographic) {
+			boundaries = append(boundaries, i)
+		}
+
+		if isRegionalIndicator(r) {
+			regionalIndicators++
+		} else {
+			regionalIndicators = 0
+		}
+
+		switch {
+		case isExtendedPictographic(r):
+			pictographic = true
+		case !extendsGrapheme(r) && r != zeroWidthJoiner:
+			pictographic = false
+		}
+
+		previous = r
+	}
+
+	return append(boundaries, len(text))
+}
+
+// continuesGrapheme returns whether the rune belongs to the same grapheme cluster as the rune before it.
+// A pictograph after a zero width joiner continues the cluster only if the joiner follows a pictograph too (rule GB11 of UAX #29).
+func continuesGrapheme(previous, r rune, regionalIndicators int, pictographic bool) bool {
+	switch {
+	case previous == '\r' && r == '\n':
+		return true
+	case previous == '\r' || previous == '\n':
+		return false
+	case extendsGrapheme(r), r == zeroWidthJoiner:
+		return true
+	case previous == zeroWidthJoiner:
+		return pictographic && isExtendedPictographic(r)
+	case isRegionalIndicator(r) && isRegionalIndicator(previous):
+		return regionalIndicators%2 == 1
+	default:
+		return false
+	}
+}
+
+// extendsGrapheme returns whether the rune stays with the character before it, like a combining mark or a variation selector.
+func extendsGrapheme(r rune) bool {
+	switch {
+	case unicode.Is(unicode.M, r):
+		return true
+	case r >= 0xfe00 && r <= 0xfe0f, r >= 0xe0100 && r <= 0xe01ef: // variation selectors
+		return true
+	case r >= 0x1f3fb && r <= 0x1f3ff: // emoji skin tone modifiers
+		return true
+	case r >= 0xe0020 && r <= 0xe007f: // tags of the subdivision flags
+		return true
+	default:
+		return false
+	}
+}
+
+// isExtendedPictographic approximates the Extended_Pictographic property with the blocks of emoji and pictographic symbols.
+func isExtendedPictographic(r rune) bool {
+	switch {
+	case isRegionalIndicator(r), r >= 0x1f3fb && r <= 0x1f3ff:
+		return false
+	case r >= 0x1f000 && r <= 0x1faff, r >= 0x2600 && r <= 0x27bf, r >= 0x2300 && r <= 0x23ff, r >= 0x2b00 && r <= 0x2bff:
+		return true
+	case r >= 0x2194 && r <= 0x21aa, r == 0xa9, r == 0xae, r == 0x203c, r == 0x2049, r == 0x2122, r == 0x2139:
+		return true
+	case r == 0x3030, r == 0x303d, r == 0x3297, r == 0x3299:
+		return true
+	default:
+		return false
+	}
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// isWordSeparator returns whether the grapheme cluster separates words, which are made of letters, digits and underscores in any script.
+func isWordSeparator(grapheme string) bool {
+	r, _ := utf8.DecodeRuneInString(grapheme)
+
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.M, r) && r != '_'
+}
+
+// isLineBreak returns whether the grapheme cluster is a line break.
+func isLineBreak(grapheme string) bool {
+	return grapheme == "\n" || grapheme == "\r\n"
+}
@@ -0,0 +1,97 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestGraphemeBoundaries(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []int
+	}{
+		{
+			name: "Empty",
+			text: "",
+			want: []int{0},
+		},
+		{
+			name: "ASCII",
+			text: "abc",
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "Polish",
+			text: "żółw",
+			want: []int{0, 2, 4, 6, 7},
+		},
+		{
+			name: "Cyrillic",
+			text: "мир",
+			want: []int{0, 2, 4, 6},
+		},
+		{
+			name: "Combining mark",
+			text: "e\u0301a",
+			want: []int{0, 3, 4},
+		},
+		{
+			name: "Emoji with a skin tone modifier",
+			text: "👍🏽!",
+			want: []int{0, 8, 9},
+		},
+		{
+			name: "Zero width joiner sequence",
+			text: "👩\u200d💻a",
+			want: []int{0, 11, 12},
+		},
+		{
+			name: "Zero width joiner sequence with skin tones",
+			text: "👩🏽\u200d💻",
+			want: []int{0, 15},
+		},
+		{
+			name: "Zero width joiner between letters",
+			text: "a\u200db",
+			want: []int{0, 4, 5},
+		},
+		{
+			name: "Zero width joiner between a letter and a pictograph",
+			text: "a\u200d💻",
+			want: []int{0, 4, 8},
+		},
+		{
+			name: "Flags",
+			text: "🇵🇱🇺🇦",
+			want: []int{0, 8, 16},
+		},
+		{
+			name: "CR LF",
+			text: "a\r\nb",
+			want: []int{0, 1, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			is.Equal(graphemeBoundaries(tt.text), tt.want)
+		})
+	}
+}
+
+func TestIsWordSeparator(t *testing.T) {
+	is := is.New(t)
+
+	is.True(!isWordSeparator("ż"))
+	is.True(!isWordSeparator("Ж"))
+	is.True(!isWordSeparator("7"))
+	is.True(!isWordSeparator("_"))
+	is.True(!isWordSeparator("e\u0301"))
+	is.True(isWordSeparator(" "))
+	is.True(isWordSeparator(","))
+	is.True(isWordSeparator("👍🏽"))
+}
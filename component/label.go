@@ -43,6 +43,7 @@ type LabelOptions struct {
 	Inverted bool
 
 	Padding *Padding
+	Tooltip *Tooltip
 }
 
 func NewLabel(text string, opt *LabelOptions) *Label {
@@ -83,6 +84,7 @@ func (l *Label) setUpComponent(opt *LabelOptions) {
 	if opt != nil {
 		componentOptions = ComponentOptions{
 			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
 		}
 	}
 
@@ -133,6 +135,11 @@ func (l *Label) align() {
 	}
 }
 
+// Text returns the label's text.
+func (l *Label) Text() string {
+	return l.text
+}
+
 func (l *Label) SetText(labelText string) {
 	// TODO: change deprecated function
 	l.bounds = text.BoundString(fontutils.DefaultFontFace, labelText) // nolint
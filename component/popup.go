@@ -0,0 +1,56 @@
+package component
+
+import (
+	"image"
+
+	"github.com/fglo/chopstiqs/event"
+)
+
+// showPopup asks the gui to show the popup next to the component, above all other components.
+// The popup stays open until it's hidden with hidePopup.
+func (c *component) showPopup(popup Component) {
+	dispatch(c, popupEvent, &ComponentPopupEventArgs{
+		Component: c.target(),
+		Popup:     popup,
+		Shown:     true,
+	}, true)
+}
+
+// showPopupAt shows the popup at the position on the screen instead of below the component, like a context menu at the mouse cursor.
+func (c *component) showPopupAt(popup Component, posX, posY int) {
+	dispatch(c, popupEvent, &ComponentPopupEventArgs{
+		Component: c.target(),
+		Popup:     popup,
+		Shown:     true,
+		Position:  &image.Point{posX, posY},
+	}, true)
+}
+
+func (c *component) hidePopup(popup Component) {
+	dispatch(c, popupEvent, &ComponentPopupEventArgs{
+		Component: c.target(),
+		Popup:     popup,
+		Shown:     false,
+	}, true)
+}
+
+func popupEvent(c *component) *event.Event[*ComponentPopupEventArgs] {
+	return c.PopupEvent
+}
+
+type ComponentPopupHandlerFunc func(args *ComponentPopupEventArgs) //nolint:golint
+// ComponentPopupEventArgs are the arguments for the events fired when a component opens or closes a popup, like a dropdown list.
+// The event propagates up to the root container, where the gui shows the popup below the component.
+type ComponentPopupEventArgs struct {
+	EventArgs
+
+	Component Component
+	Popup     Component
+	Shown     bool
+	// Position is where the popup is placed on the screen. If it's nil, the popup is placed below the component.
+	Position *image.Point
+}
+
+func (c *component) AddPopupHandler(f ComponentPopupHandlerFunc) event.RemoveHandlerFunc {
+	return c.PopupEvent.AddHandler(event.HandlerFunc[*ComponentPopupEventArgs](f))
+}
@@ -0,0 +1,322 @@
+package component
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// progressBarIndeterminatePeriod is the time the block of an indeterminate progress bar takes to sweep across the bar.
+var progressBarIndeterminatePeriod = 1500 * time.Millisecond
+
+// ProgressBar shows the progress of a value between the min and max values, like a loading progress or a health bar.
+// It isn't interactive.
+type ProgressBar struct {
+	component
+
+	min   float64
+	max   float64
+	value float64
+
+	vertical bool
+
+	indeterminate bool
+	// indeterminateOffset is the position of the sweeping block of an indeterminate progress bar, from 0 to 1
+	indeterminateOffset float64
+	indeterminateStart  time.Time
+	indeterminateTask   *timer.Handle
+
+	label       *Label
+	labelFormat func(progressBar *ProgressBar) string
+
+	drawer ProgressBarDrawer
+}
+
+type ProgressBarOptions struct {
+	// Min is the value of an empty progress bar. If not set, it's 0.
+	Min option.OptFloat
+	// Max is the value of a full progress bar. If not set, it's 100.
+	Max   option.OptFloat
+	Value option.OptFloat
+
+	Width  option.OptInt
+	Height option.OptInt
+
+	// Vertical makes the progress bar fill from the bottom up instead of from the left to the right.
+	Vertical bool
+	// Indeterminate makes the progress bar show a block sweeping across it, for progress of an unknown length.
+	Indeterminate bool
+
+	// Label is centred on the progress bar and shows the progress as a percentage. It's hidden while the progress bar is indeterminate.
+	// A progress bar narrower than the label's text is widened to fit it.
+	Label *Label
+	// LabelFormat formats the label's text, for example to show "3/10 MB" instead of the percentage.
+	LabelFormat func(progressBar *ProgressBar) string
+
+	Padding *Padding
+	Tooltip *Tooltip
+
+	Drawer ProgressBarDrawer
+}
+
+func NewProgressBar(opt *ProgressBarOptions) *ProgressBar {
+	pb := &ProgressBar{
+		max: 100,
+
+		labelFormat: func(progressBar *ProgressBar) string {
+			return fmt.Sprintf("%d%%", int(math.Round(progressBar.Progress()*100)))
+		},
+
+		drawer: DefaultProgressBarDrawer{
+			Color:         color.RGBA{230, 230, 230, 255},
+			ColorDisabled: color.RGBA{150, 150, 150, 255},
+		},
+	}
+
+	width := 45
+	height := 15
+
+	if opt != nil {
+		if opt.Vertical {
+			pb.vertical = true
+			width, height = height, width
+		}
+
+		if opt.Width.IsSet() {
+			width = opt.Width.Val()
+		}
+
+		if opt.Height.IsSet() {
+			height = opt.Height.Val()
+		}
+
+		if opt.Min.IsSet() {
+			pb.min = opt.Min.Val()
+		}
+
+		if opt.Max.IsSet() {
+			pb.max = opt.Max.Val()
+		}
+
+		if opt.LabelFormat != nil {
+			pb.labelFormat = opt.LabelFormat
+		}
+
+		if opt.Drawer != nil {
+			pb.drawer = opt.Drawer
+		}
+	}
+
+	pb.SetDimensions(width, height)
+	pb.value = pb.min
+
+	pb.setUpComponent(opt)
+
+	if opt != nil {
+		if opt.Label != nil {
+			pb.SetLabel(opt.Label)
+		}
+
+		if opt.Value.IsSet() {
+			pb.Set(opt.Value.Val())
+		}
+
+		pb.SetIndeterminate(opt.Indeterminate)
+	}
+
+	return pb
+}
+
+func (pb *ProgressBar) setUpComponent(opt *ProgressBarOptions) {
+	var componentOptions ComponentOptions
+
+	if opt != nil {
+		componentOptions = ComponentOptions{
+			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
+		}
+	}
+
+	pb.component.setUpComponent(&componentOptions)
+}
+
+// SetLabel sets the label centred on the progress bar, which shows the progress.
+// The progress bar is widened whenever the label's text doesn't fit it.
+func (pb *ProgressBar) SetLabel(label *Label) {
+	label.setContainer(pb)
+	pb.label = label
+	pb.label.horizontalAlignment = option.AlignmentCenteredHorizontally
+	pb.label.verticalAlignment = option.AlignmentCenteredVertically
+
+	pb.updateLabel()
+}
+
+func (pb *ProgressBar) updateLabel() {
+	if pb.label == nil {
+		return
+	}
+
+	pb.label.SetHidden(pb.indeterminate)
+	pb.label.SetText(pb.labelFormat(pb))
+
+	if pb.width < pb.label.widthWithPadding {
+		pb.SetWidth(pb.label.widthWithPadding)
+	}
+
+	pb.label.align()
+}
+
+// Set sets the value of the progress bar, clamped to its min and max values.
+func (pb *ProgressBar) Set(value float64) {
+	pb.value = math.Max(pb.min, math.Min(pb.max, value))
+	pb.updateLabel()
+}
+
+func (pb *ProgressBar) GetValue() float64 {
+	return pb.value
+}
+
+func (pb *ProgressBar) Min() float64 {
+	return pb.min
+}
+
+func (pb *ProgressBar) Max() float64 {
+	return pb.max
+}
+
+// SetRange sets the min and max values of the progress bar, clamping its value to them.
+func (pb *ProgressBar) SetRange(min, max float64) {
+	pb.min = min
+	pb.max = max
+	pb.Set(pb.value)
+}
+
+// Progress returns the progress of the value from the min value to the max value, from 0 to 1.
+func (pb *ProgressBar) Progress() float64 {
+	if pb.max <= pb.min {
+		return 0
+	}
+
+	return (pb.value - pb.min) / (pb.max - pb.min)
+}
+
+func (pb *ProgressBar) Vertical() bool {
+	return pb.vertical
+}
+
+func (pb *ProgressBar) Indeterminate() bool {
+	return pb.indeterminate
+}
+
+// SetIndeterminate sets whether the progress bar shows a block sweeping across it instead of its value.
+func (pb *ProgressBar) SetIndeterminate(indeterminate bool) {
+	pb.indeterminate = indeterminate
+	pb.updateLabel()
+	pb.updateIndeterminateTask()
+}
+
+// IndeterminateOffset returns the position of the sweeping block of an indeterminate progress bar, from 0 to 1.
+func (pb *ProgressBar) IndeterminateOffset() float64 {
+	return pb.indeterminateOffset
+}
+
+// updateIndeterminateTask starts moving the sweeping block every frame while the indeterminate progress bar is shown in a container
+// and stops it otherwise.
+func (pb *ProgressBar) updateIndeterminateTask() {
+	pb.indeterminateTask.Cancel()
+	pb.indeterminateTask = nil
+	pb.indeterminateOffset = 0
+
+	if !pb.indeterminate || pb.hidden || pb.container == nil {
+		return
+	}
+
+	scheduler := pb.eventManager.Scheduler()
+	pb.indeterminateStart = scheduler.Now()
+	pb.indeterminateTask = scheduler.EveryFrames(1, func() {
+		// the progress bar was removed from its container
+		if pb.container == nil {
+			pb.updateIndeterminateTask()
+			return
+		}
+
+		elapsed := scheduler.Now().Sub(pb.indeterminateStart) % progressBarIndeterminatePeriod
+		pb.indeterminateOffset = float64(elapsed) / float64(progressBarIndeterminatePeriod)
+	})
+}
+
+// SetEventManager sets the event manager, restarting the sweeping block of an indeterminate progress bar on the manager's scheduler.
+func (pb *ProgressBar) SetEventManager(eventManager *event.Manager) {
+	pb.component.SetEventManager(eventManager)
+	pb.updateIndeterminateTask()
+}
+
+func (pb *ProgressBar) setContainer(container container) {
+	pb.component.setContainer(container)
+	pb.updateIndeterminateTask()
+}
+
+// SetHidden sets the progress bar's hidden state. The sweeping block of an indeterminate progress bar stops while it's hidden.
+func (pb *ProgressBar) SetHidden(hidden bool) {
+	if hidden == pb.hidden {
+		return
+	}
+
+	pb.component.SetHidden(hidden)
+	pb.updateIndeterminateTask()
+}
+
+func (pb *ProgressBar) SetPosition(posX, posY float64) {
+	pb.component.SetPosition(posX, posY)
+	if pb.label != nil {
+		pb.label.RecalculateAbsPosition()
+	}
+}
+
+func (pb *ProgressBar) RecalculateAbsPosition() {
+	pb.component.RecalculateAbsPosition()
+	if pb.label != nil {
+		pb.label.RecalculateAbsPosition()
+	}
+}
+
+func (pb *ProgressBar) SetBackgroundColor(color color.RGBA) {
+	pb.container.SetBackgroundColor(color)
+}
+
+func (pb *ProgressBar) GetBackgroundColor() color.RGBA {
+	return pb.container.GetBackgroundColor()
+}
+
+func (pb *ProgressBar) FireEvents(in input.InputSource) {
+	if pb.label != nil {
+		pb.label.FireEvents(in)
+	}
+
+	pb.component.FireEvents(in)
+}
+
+func (pb *ProgressBar) Draw() *ebiten.Image {
+	if pb.hidden {
+		return pb.image
+	}
+
+	pb.drawer.Draw(pb)
+
+	if pb.label != nil && !pb.label.Hidden() {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pb.label.Position())
+		pb.image.DrawImage(pb.label.Draw(), op)
+	}
+
+	pb.component.Draw()
+
+	return pb.image
+}
@@ -0,0 +1,94 @@
+package component
+
+import (
+	"image/color"
+	"math"
+
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+type ProgressBarDrawer interface {
+	Draw(progressBar *ProgressBar) *ebiten.Image
+}
+
+// DefaultProgressBarDrawer draws the progress bar like the default slider drawer draws the slider's track,
+// filled up to the progress or with the sweeping block of an indeterminate progress bar.
+type DefaultProgressBarDrawer struct {
+	Color         color.RGBA
+	ColorDisabled color.RGBA
+}
+
+func (d DefaultProgressBarDrawer) Draw(pb *ProgressBar) *ebiten.Image {
+	arr := make([]byte, pb.component.pixelRows*pb.component.pixelCols)
+	backgroundColor := pb.container.GetBackgroundColor()
+
+	clr := d.Color
+	if pb.disabled {
+		clr = d.ColorDisabled
+	}
+
+	fillFrom, fillTo := d.fillRange(pb)
+
+	for y := 0; y < pb.height; y++ {
+		rowNumber := pb.component.pixelCols * (y + pb.padding.Top)
+
+		for x := 0; x < pb.width; x++ {
+			colId := (x + pb.padding.Left) * 4
+
+			c := backgroundColor
+			switch {
+			case d.isCorner(pb, x, y):
+			case d.isBorder(pb, x, y):
+				c = clr
+			case d.isColored(pb, x, y):
+				// the fill is measured along the bar, from the left or the bottom of its inside
+				i := x - 2
+				if pb.vertical {
+					i = pb.height - 3 - y
+				}
+
+				if i >= fillFrom && i < fillTo {
+					c = clr
+				}
+			}
+
+			arr[colId+rowNumber] = c.R
+			arr[colId+1+rowNumber] = c.G
+			arr[colId+2+rowNumber] = c.B
+			arr[colId+3+rowNumber] = c.A
+		}
+	}
+
+	pb.image.WritePixels(arr)
+
+	return pb.image
+}
+
+// fillRange returns the range of the filled pixels along the inside of the bar.
+func (d DefaultProgressBarDrawer) fillRange(pb *ProgressBar) (int, int) {
+	length := pb.width - 4
+	if pb.vertical {
+		length = pb.height - 4
+	}
+
+	if !pb.Indeterminate() {
+		return 0, int(math.Round(pb.Progress() * float64(length)))
+	}
+
+	block := length / 3
+	from := int(math.Round(pb.IndeterminateOffset()*float64(length+block))) - block
+
+	return from, from + block
+}
+
+func (d DefaultProgressBarDrawer) isCorner(pb *ProgressBar, x, y int) bool {
+	return (x == 0 || x == pb.width-1) && (y == 0 || y == pb.height-1)
+}
+
+func (d DefaultProgressBarDrawer) isBorder(pb *ProgressBar, x, y int) bool {
+	return x == 0 || x == pb.width-1 || y == 0 || y == pb.height-1
+}
+
+func (d DefaultProgressBarDrawer) isColored(pb *ProgressBar, x, y int) bool {
+	return x > 1 && x < pb.width-2 && y > 1 && y < pb.height-2
+}
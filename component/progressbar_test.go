@@ -0,0 +1,111 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/matryer/is"
+)
+
+func TestProgressBar_Set(t *testing.T) {
+	is := is.New(t)
+
+	pb := NewProgressBar(&ProgressBarOptions{
+		Min:   option.Float(10),
+		Max:   option.Float(20),
+		Label: NewLabel("", nil),
+	})
+	is.Equal(pb.GetValue(), 10.0)
+	is.Equal(pb.label.text, "0%")
+
+	pb.Set(15)
+	is.Equal(pb.Progress(), 0.5)
+	is.Equal(pb.label.text, "50%")
+
+	pb.Set(30) // the value is clamped
+	is.Equal(pb.GetValue(), 20.0)
+	is.Equal(pb.label.text, "100%")
+
+	pb.SetRange(0, 40)
+	is.Equal(pb.Progress(), 0.5)
+}
+
+func TestProgressBar_LabelFormat(t *testing.T) {
+	is := is.New(t)
+
+	pb := NewProgressBar(&ProgressBarOptions{
+		Max:   option.Float(10),
+		Value: option.Float(3),
+		Width: option.Int(80),
+		Label: NewLabel("", nil),
+		LabelFormat: func(progressBar *ProgressBar) string {
+			return "3/10 MB"
+		},
+	})
+	is.Equal(pb.label.text, "3/10 MB")
+
+	// the label is centred on the bar
+	x, _ := pb.label.Position()
+	is.Equal(x, float64(80-pb.label.bounds.Dx())/2)
+}
+
+func TestProgressBar_LabelWidensBar(t *testing.T) {
+	is := is.New(t)
+
+	pb := NewProgressBar(&ProgressBarOptions{Width: option.Int(10), Label: NewLabel("", nil)})
+	is.Equal(pb.Width(), pb.label.widthWithPadding) // "0%" doesn't fit into the bar
+
+	pb.Set(100)
+	is.Equal(pb.label.text, "100%")
+	is.Equal(pb.Width(), pb.label.widthWithPadding) // the bar is widened again for the longer text
+
+	x, _ := pb.label.Position()
+	is.Equal(x, 0.0)
+}
+
+func TestProgressBar_Indeterminate(t *testing.T) {
+	is := is.New(t)
+
+	root := newTestRootContainer()
+	root.SetEventManager(newTestEventManager())
+	scheduler := root.eventManager.Scheduler()
+
+	wait := func(d time.Duration) {
+		scheduler.Clock().(*timer.FakeClock).Advance(d)
+		scheduler.Tick()
+	}
+
+	pb := NewProgressBar(&ProgressBarOptions{Indeterminate: true, Label: NewLabel("", nil)})
+	root.AddComponent(pb)
+	is.True(pb.label.Hidden())
+
+	wait(progressBarIndeterminatePeriod / 4)
+	is.Equal(pb.IndeterminateOffset(), 0.25)
+
+	wait(progressBarIndeterminatePeriod)
+	is.Equal(pb.IndeterminateOffset(), 0.25) // the block sweeps across the bar again
+
+	// the block stops while the progress bar is hidden
+	pb.SetHidden(true)
+	is.True(!pb.indeterminateTask.Active())
+
+	pb.SetHidden(false)
+	wait(progressBarIndeterminatePeriod / 2)
+	is.Equal(pb.IndeterminateOffset(), 0.5)
+
+	// and when it's removed from its container
+	root.RemoveComponent(pb)
+	wait(progressBarIndeterminatePeriod / 4)
+	is.True(!pb.indeterminateTask.Active())
+
+	root.AddComponent(pb)
+	wait(progressBarIndeterminatePeriod / 4)
+	is.Equal(pb.IndeterminateOffset(), 0.25)
+
+	pb.SetIndeterminate(false)
+	wait(progressBarIndeterminatePeriod / 4)
+	is.Equal(pb.IndeterminateOffset(), 0.0)
+	is.True(!pb.label.Hidden())
+}
@@ -0,0 +1,252 @@
+package component
+
+import (
+	"image/color"
+
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// RadioButton is a button that can only be selected, not deselected, by clicking it.
+// Radio buttons added to a RadioGroup are deselected when another button of the group is selected.
+type RadioButton struct {
+	component
+	selected bool
+
+	group *RadioGroup
+
+	label *Label
+
+	rbWidth  int
+	rbHeight int
+
+	drawer RadioButtonDrawer
+}
+
+type RadioButtonOptions struct {
+	Width  option.OptInt
+	Height option.OptInt
+
+	Label *Label
+
+	Padding *Padding
+	Tooltip *Tooltip
+
+	Drawer RadioButtonDrawer
+}
+
+func NewRadioButton(opt *RadioButtonOptions) *RadioButton {
+	rb := &RadioButton{
+		rbWidth:  10,
+		rbHeight: 10,
+
+		drawer: DefaultRadioButtonDrawer{
+			Color:        color.RGBA{230, 230, 230, 255},
+			ColorFocused: color.RGBA{90, 160, 250, 255},
+		},
+	}
+
+	if opt != nil {
+		if opt.Width.IsSet() {
+			rb.rbWidth = opt.Width.Val()
+		}
+
+		if opt.Height.IsSet() {
+			rb.rbHeight = opt.Height.Val()
+		}
+	}
+
+	rb.SetDimensions(rb.rbWidth, rb.rbHeight)
+
+	if opt != nil {
+		if opt.Label != nil {
+			rb.SetLabel(opt.Label)
+		}
+
+		if opt.Drawer != nil {
+			rb.drawer = opt.Drawer
+		}
+	}
+
+	rb.setUpComponent(opt)
+
+	return rb
+}
+
+func (rb *RadioButton) setUpComponent(opt *RadioButtonOptions) {
+	var componentOptions ComponentOptions
+
+	if opt != nil {
+		componentOptions = ComponentOptions{
+			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
+		}
+	}
+
+	rb.component.setUpComponent(&componentOptions)
+	rb.focusable = true
+
+	rb.MouseButtonReleasedEvent.AddDefaultHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+		if !rb.disabled && args.Inside {
+			rb.Select()
+		}
+	})
+}
+
+// Label returns the label of the radio button, or nil if it has none.
+func (rb *RadioButton) Label() *Label {
+	return rb.label
+}
+
+// SetLabel sets the label of the radio button and adjusts the radio button's dimensions accordingly.
+func (rb *RadioButton) SetLabel(label *Label) {
+	label.setContainer(rb)
+	rb.label = label
+	rb.label.horizontalAlignment = option.AlignmentRight
+	rb.label.verticalAlignment = option.AlignmentCenteredVertically
+
+	if rb.label.padding.Left == 0 {
+		rb.label.SetPaddingLeft(2)
+	}
+
+	width := rb.width
+	if width <= rb.rbWidth+rb.label.widthWithPadding {
+		width = rb.rbWidth + rb.label.widthWithPadding
+	}
+
+	height := rb.height
+	if height <= rb.label.height {
+		height = rb.label.height
+	}
+
+	rb.SetDimensions(width, height)
+
+	rb.label.align()
+}
+
+// Group returns the radio group the radio button belongs to, or nil if it doesn't belong to any.
+func (rb *RadioButton) Group() *RadioGroup {
+	return rb.group
+}
+
+// Selected returns whether the radio button is selected.
+func (rb *RadioButton) Selected() bool {
+	return rb.selected
+}
+
+// Select selects the radio button, deselecting the other buttons of its group.
+func (rb *RadioButton) Select() {
+	if rb.group != nil {
+		rb.group.SetSelected(rb)
+		return
+	}
+
+	rb.selected = true
+}
+
+// Activate selects the radio button as if it was clicked with the mouse.
+// It is called when the radio button is focused and activated with the keyboard.
+func (rb *RadioButton) Activate() {
+	if !rb.disabled {
+		rb.Select()
+	}
+}
+
+// Increment selects and focuses the next button of the radio button's group.
+func (rb *RadioButton) Increment() {
+	rb.selectSibling(1)
+}
+
+// Decrement selects and focuses the previous button of the radio button's group.
+func (rb *RadioButton) Decrement() {
+	rb.selectSibling(-1)
+}
+
+// selectSibling selects the closest enabled and visible button of the group in the direction of the step, wrapping around.
+func (rb *RadioButton) selectSibling(step int) {
+	if rb.group == nil || rb.disabled {
+		return
+	}
+
+	buttons := rb.group.buttons
+	i := rb.group.index(rb)
+
+	for n := 1; n < len(buttons); n++ {
+		sibling := buttons[(i+n*step+n*len(buttons))%len(buttons)]
+		if sibling.disabled || sibling.hidden {
+			continue
+		}
+
+		rb.group.SetSelected(sibling)
+		if rb.focused {
+			// the focus is moved after the events of the update are handled, so the sibling doesn't handle the same key press
+			rb.eventManager.Post(func() {
+				rb.SetFocused(false)
+				sibling.SetFocused(true)
+			})
+		}
+
+		return
+	}
+}
+
+func (rb *RadioButton) SetPosition(posX, posY float64) {
+	rb.component.SetPosition(posX, posY)
+	if rb.label != nil {
+		rb.label.RecalculateAbsPosition()
+	}
+}
+
+func (rb *RadioButton) RecalculateAbsPosition() {
+	rb.component.RecalculateAbsPosition()
+	if rb.label != nil {
+		rb.label.RecalculateAbsPosition()
+	}
+}
+
+func (rb *RadioButton) SetBackgroundColor(color color.RGBA) {
+	rb.container.SetBackgroundColor(color)
+}
+
+func (rb *RadioButton) GetBackgroundColor() color.RGBA {
+	return rb.container.GetBackgroundColor()
+}
+
+// FireEvents fires the radio button's events. The arrow keys move the selection between the buttons of the group while the radio button is focused.
+func (rb *RadioButton) FireEvents(in input.InputSource) {
+	if rb.label != nil {
+		rb.label.FireEvents(in)
+	}
+
+	rb.component.FireEvents(in)
+
+	if !rb.focused {
+		return
+	}
+
+	switch {
+	case in.KeyJustPressed(ebiten.KeyDown) || in.KeyJustPressed(ebiten.KeyRight):
+		rb.Increment()
+	case in.KeyJustPressed(ebiten.KeyUp) || in.KeyJustPressed(ebiten.KeyLeft):
+		rb.Decrement()
+	}
+}
+
+func (rb *RadioButton) Draw() *ebiten.Image {
+	if rb.hidden {
+		return rb.image
+	}
+
+	rb.drawer.Draw(rb)
+
+	if rb.label != nil {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(rb.label.Position())
+		rb.image.DrawImage(rb.label.Draw(), op)
+	}
+
+	rb.component.Draw()
+
+	return rb.image
+}
@@ -0,0 +1,77 @@
+package component
+
+import (
+	"image/color"
+
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+type RadioButtonDrawer interface {
+	Draw(radioButton *RadioButton) *ebiten.Image
+}
+
+// DefaultRadioButtonDrawer draws the radio button as a pixel circle, with a dot inside when it's selected.
+type DefaultRadioButtonDrawer struct {
+	Color color.RGBA
+	// ColorFocused is the color of the focused radio button's circle. If it's transparent, the focus isn't drawn.
+	ColorFocused color.RGBA
+}
+
+func (d DefaultRadioButtonDrawer) Draw(rb *RadioButton) *ebiten.Image {
+	arr := make([]byte, rb.component.pixelRows*rb.component.pixelCols)
+	backgroundColor := rb.container.GetBackgroundColor()
+
+	borderColor := d.Color
+	if rb.focused && d.ColorFocused.A > 0 {
+		borderColor = d.ColorFocused
+	}
+
+	for y := 0; y < rb.rbHeight; y++ {
+		rowNumber := rb.component.pixelCols * (y + rb.padding.Top)
+
+		for x := 0; x < rb.rbWidth; x++ {
+			colId := (x + rb.padding.Left) * 4
+
+			c := backgroundColor
+			switch {
+			case d.isBorder(rb, x, y):
+				c = borderColor
+			case rb.Selected() && d.isDot(rb, x, y):
+				c = d.Color
+			}
+
+			arr[colId+rowNumber] = c.R
+			arr[colId+1+rowNumber] = c.G
+			arr[colId+2+rowNumber] = c.B
+			arr[colId+3+rowNumber] = c.A
+		}
+	}
+
+	rb.image.WritePixels(arr)
+
+	return rb.image
+}
+
+// isBorder returns whether the pixel is on the one pixel wide edge of the circle.
+func (d DefaultRadioButtonDrawer) isBorder(rb *RadioButton, x, y int) bool {
+	return d.inEllipse(rb, x, y, 0) && !d.inEllipse(rb, x, y, 1)
+}
+
+// isDot returns whether the pixel is in the dot drawn inside a selected radio button.
+func (d DefaultRadioButtonDrawer) isDot(rb *RadioButton, x, y int) bool {
+	return d.inEllipse(rb, x, y, 2)
+}
+
+// inEllipse returns whether the pixel's center is inside the ellipse inscribed in the radio button, shrunk by the inset.
+func (d DefaultRadioButtonDrawer) inEllipse(rb *RadioButton, x, y int, inset float64) bool {
+	rx := float64(rb.rbWidth)/2 - inset
+	ry := float64(rb.rbHeight)/2 - inset
+	if rx <= 0 || ry <= 0 {
+		return false
+	}
+
+	dx := (float64(x) + 0.5 - float64(rb.rbWidth)/2) / rx
+	dy := (float64(y) + 0.5 - float64(rb.rbHeight)/2) / ry
+
+	return dx*dx+dy*dy <= 1
+}
@@ -0,0 +1,61 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/event"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestRadioButton_Click(t *testing.T) {
+	is := is.New(t)
+
+	rb := NewRadioButton(nil)
+	rb.SetEventManager(event.NewManager())
+
+	leftMouseButtonClick(t, &rb.component)
+	is.True(rb.Selected())
+
+	// a radio button isn't deselected by clicking it again
+	leftMouseButtonClick(t, &rb.component)
+	is.True(rb.Selected())
+}
+
+func TestRadioButton_Disabled(t *testing.T) {
+	is := is.New(t)
+
+	rb := NewRadioButton(nil)
+	rb.SetEventManager(event.NewManager())
+	rb.SetDisabled(true)
+
+	leftMouseButtonClick(t, &rb.component)
+	rb.Activate()
+	is.True(!rb.Selected())
+}
+
+func TestRadioButton_arrowKeys(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	buttons := []*RadioButton{NewRadioButton(nil), NewRadioButton(nil), NewRadioButton(nil)}
+	g := NewRadioGroup(&RadioGroupOptions{Buttons: buttons})
+	root := newTestRootContainer(buttons[0], buttons[1], buttons[2])
+	buttons[1].SetDisabled(true)
+	buttons[0].SetFocused(true)
+
+	in.PressKey(ebiten.KeyDown)
+	fireRootEvents(t, root, in)
+	is.Equal(g.Selected(), buttons[2]) // the disabled button is skipped
+	is.True(buttons[2].Focused())      // the focus follows the selection
+
+	in.ReleaseAll()
+	in.PressKey(ebiten.KeyDown)
+	fireRootEvents(t, root, in)
+	is.Equal(g.Selected(), buttons[0]) // the selection wraps around
+
+	in.ReleaseAll()
+	in.PressKey(ebiten.KeyUp)
+	fireRootEvents(t, root, in)
+	is.Equal(g.Selected(), buttons[2])
+}
@@ -0,0 +1,157 @@
+package component
+
+import (
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/option"
+)
+
+// RadioGroup keeps at most one of its radio buttons selected. It isn't a component itself,
+// so its buttons can be placed anywhere in the container tree.
+type RadioGroup struct {
+	buttons  []*RadioButton
+	selected *RadioButton
+
+	SelectionChangedEvent *event.Event[*RadioGroupSelectionChangedEventArgs]
+}
+
+type RadioGroupOptions struct {
+	Buttons []*RadioButton
+	// SelectedIndex is the index of the button selected initially. If not set, no button is selected.
+	SelectedIndex option.OptInt
+}
+
+type RadioGroupSelectionChangedEventArgs struct {
+	RadioGroup *RadioGroup
+	// Selected is the newly selected button. It's nil if the selection was cleared.
+	Selected *RadioButton
+	Previous *RadioButton
+}
+
+type RadioGroupSelectionChangedHandlerFunc func(args *RadioGroupSelectionChangedEventArgs)
+
+func NewRadioGroup(opt *RadioGroupOptions) *RadioGroup {
+	g := &RadioGroup{
+		SelectionChangedEvent: &event.Event[*RadioGroupSelectionChangedEventArgs]{},
+	}
+
+	if opt != nil {
+		g.AddButton(opt.Buttons...)
+
+		if i := opt.SelectedIndex.Val(); opt.SelectedIndex.IsSet() && i >= 0 && i < len(g.buttons) {
+			g.selected = g.buttons[i]
+			g.selected.selected = true
+		}
+	}
+
+	return g
+}
+
+func (g *RadioGroup) AddSelectionChangedHandler(f RadioGroupSelectionChangedHandlerFunc) event.RemoveHandlerFunc {
+	return g.SelectionChangedEvent.AddHandler(event.HandlerFunc[*RadioGroupSelectionChangedEventArgs](f))
+}
+
+// AddButton adds the radio buttons to the group, removing them from their previous groups.
+// A selected button is deselected if the group already has a selected button.
+func (g *RadioGroup) AddButton(buttons ...*RadioButton) {
+	for _, rb := range buttons {
+		if rb.group == g {
+			continue
+		}
+
+		if rb.group != nil {
+			rb.group.RemoveButton(rb)
+		}
+
+		rb.group = g
+		g.buttons = append(g.buttons, rb)
+
+		if rb.selected {
+			if g.selected == nil {
+				g.selected = rb
+			} else {
+				rb.selected = false
+			}
+		}
+	}
+}
+
+// RemoveButton removes the radio button from the group. The button keeps its selection state,
+// but the group's selection is cleared if it was the selected button.
+func (g *RadioGroup) RemoveButton(rb *RadioButton) {
+	i := g.index(rb)
+	if i == -1 {
+		return
+	}
+
+	g.buttons = append(g.buttons[:i], g.buttons[i+1:]...)
+	rb.group = nil
+
+	if g.selected == rb {
+		g.selected = nil
+	}
+}
+
+// Buttons returns the radio buttons of the group.
+func (g *RadioGroup) Buttons() []*RadioButton {
+	return g.buttons
+}
+
+// Selected returns the selected radio button, or nil if no button is selected.
+func (g *RadioGroup) Selected() *RadioButton {
+	return g.selected
+}
+
+// SelectedIndex returns the index of the selected radio button, or -1 if no button is selected.
+func (g *RadioGroup) SelectedIndex() int {
+	return g.index(g.selected)
+}
+
+// SetSelected selects the radio button and deselects the previously selected one. Nil clears the selection.
+// Buttons that don't belong to the group are ignored. The SelectionChangedEvent is fired once, only if the selection changes.
+func (g *RadioGroup) SetSelected(rb *RadioButton) {
+	if rb == g.selected || rb != nil && rb.group != g {
+		return
+	}
+
+	previous := g.selected
+	g.selected = rb
+
+	// the group isn't a component, so the event is fired with the event manager of one of the buttons
+	var eventManager *event.Manager
+
+	if previous != nil {
+		previous.selected = false
+		eventManager = previous.eventManager
+	}
+
+	if rb != nil {
+		rb.selected = true
+		eventManager = rb.eventManager
+	}
+
+	event.Fire(eventManager, g.SelectionChangedEvent, &RadioGroupSelectionChangedEventArgs{
+		RadioGroup: g,
+		Selected:   rb,
+		Previous:   previous,
+	})
+}
+
+// SetSelectedIndex selects the radio button at the index. -1 clears the selection and other indexes out of range are ignored.
+func (g *RadioGroup) SetSelectedIndex(i int) {
+	switch {
+	case i == -1:
+		g.SetSelected(nil)
+	case i >= 0 && i < len(g.buttons):
+		g.SetSelected(g.buttons[i])
+	}
+}
+
+func (g *RadioGroup) index(rb *RadioButton) int {
+	for i, b := range g.buttons {
+		if b == rb {
+			return i
+		}
+	}
+
+	return -1
+}
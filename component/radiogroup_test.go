@@ -0,0 +1,109 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/matryer/is"
+)
+
+func newTestRadioGroup(n int, opt *RadioGroupOptions) (*RadioGroup, *event.Manager) {
+	eventManager := event.NewManager()
+
+	if opt == nil {
+		opt = &RadioGroupOptions{}
+	}
+
+	for i := 0; i < n; i++ {
+		rb := NewRadioButton(nil)
+		rb.SetEventManager(eventManager)
+		opt.Buttons = append(opt.Buttons, rb)
+	}
+
+	return NewRadioGroup(opt), eventManager
+}
+
+func TestRadioGroup_SetSelected(t *testing.T) {
+	is := is.New(t)
+
+	g, eventManager := newTestRadioGroup(3, nil)
+	buttons := g.Buttons()
+	is.Equal(g.Selected(), (*RadioButton)(nil))
+	is.Equal(g.SelectedIndex(), -1)
+
+	var events []*RadioGroupSelectionChangedEventArgs
+	g.AddSelectionChangedHandler(func(args *RadioGroupSelectionChangedEventArgs) {
+		events = append(events, args)
+	})
+
+	g.SetSelected(buttons[1])
+	eventManager.HandleFired()
+	is.True(buttons[1].Selected())
+	is.Equal(len(events), 1)
+	is.Equal(events[0].Selected, buttons[1])
+	is.Equal(events[0].Previous, (*RadioButton)(nil))
+
+	g.SetSelected(buttons[2])
+	eventManager.HandleFired()
+	is.True(!buttons[1].Selected())
+	is.True(buttons[2].Selected())
+	is.Equal(g.SelectedIndex(), 2)
+	is.Equal(len(events), 2) // a single event for the deselected and the selected button
+	is.Equal(events[1].Previous, buttons[1])
+
+	g.SetSelected(buttons[2])          // already selected
+	g.SetSelected(NewRadioButton(nil)) // not in the group
+	g.SetSelectedIndex(3)              // out of range
+	eventManager.HandleFired()
+	is.Equal(len(events), 2)
+	is.Equal(g.Selected(), buttons[2])
+
+	g.SetSelected(nil)
+	eventManager.HandleFired()
+	is.True(!buttons[2].Selected())
+	is.Equal(len(events), 3)
+	is.Equal(events[2].Selected, (*RadioButton)(nil))
+}
+
+func TestRadioGroup_Click(t *testing.T) {
+	is := is.New(t)
+
+	g, _ := newTestRadioGroup(3, &RadioGroupOptions{SelectedIndex: option.Int(0)})
+	buttons := g.Buttons()
+	is.True(buttons[0].Selected())
+
+	changed := 0
+	g.AddSelectionChangedHandler(func(args *RadioGroupSelectionChangedEventArgs) {
+		changed++
+	})
+
+	leftMouseButtonClick(t, &buttons[2].component)
+	is.Equal(g.Selected(), buttons[2])
+	is.True(!buttons[0].Selected())
+	is.Equal(changed, 1)
+
+	leftMouseButtonClick(t, &buttons[2].component)
+	is.Equal(changed, 1)
+}
+
+func TestRadioGroup_AddButton(t *testing.T) {
+	is := is.New(t)
+
+	g, _ := newTestRadioGroup(2, &RadioGroupOptions{SelectedIndex: option.Int(1)})
+	other, _ := newTestRadioGroup(1, &RadioGroupOptions{SelectedIndex: option.Int(0)})
+	rb := other.Buttons()[0]
+
+	// the moved button is deselected, as the group already has a selected button
+	g.AddButton(rb)
+	is.Equal(rb.Group(), g)
+	is.Equal(len(g.Buttons()), 3)
+	is.Equal(len(other.Buttons()), 0)
+	is.Equal(other.Selected(), (*RadioButton)(nil))
+	is.True(!rb.Selected())
+	is.Equal(g.SelectedIndex(), 1)
+
+	g.RemoveButton(g.Buttons()[1])
+	is.Equal(g.Selected(), (*RadioButton)(nil))
+	is.Equal(len(g.Buttons()), 2)
+}
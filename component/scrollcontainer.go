@@ -0,0 +1,541 @@
+package component
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// scrollbarThickness is the width of the vertical and the height of the horizontal scrollbar.
+const scrollbarThickness = 7
+
+// ScrollContainer is a viewport to a content container larger than itself.
+// The content is clipped to the viewport's bounds and scrolled with the mouse wheel or the scrollbars.
+type ScrollContainer struct {
+	component
+
+	content *Container
+
+	scrollX    int
+	scrollY    int
+	scrollStep int
+
+	verticalScrollbar   bool
+	horizontalScrollbar bool
+
+	verticalThumb   *Button
+	horizontalThumb *Button
+
+	draggedThumb *Button
+	// dragOffset is the distance between the cursor and the dragged thumb's start along the scrollbar
+	dragOffset int
+
+	// cursorCaptured is set when a mouse button is pressed inside of the viewport and cleared when all buttons are released,
+	// so the content keeps receiving the cursor position while it's dragged out of the viewport.
+	cursorCaptured bool
+
+	backgroundColor color.RGBA
+
+	ScrolledEvent *event.Event[*ScrollContainerScrolledEventArgs]
+
+	drawer ScrollContainerDrawer
+}
+
+type ScrollContainerOptions struct {
+	// Layout is the layout of the scrolled content.
+	Layout Layout
+
+	Width  option.OptInt
+	Height option.OptInt
+
+	Padding *Padding
+
+	VerticalScrollbar   bool
+	HorizontalScrollbar bool
+
+	// ScrollStep is the number of pixels the content is scrolled by per mouse wheel step.
+	ScrollStep option.OptInt
+
+	Drawer      ScrollContainerDrawer
+	ThumbDrawer ButtonDrawer
+}
+
+type ScrollContainerScrolledEventArgs struct {
+	ScrollContainer *ScrollContainer
+	ScrollX         int
+	ScrollY         int
+}
+
+type ScrollContainerScrolledHandlerFunc func(args *ScrollContainerScrolledEventArgs)
+
+// NewScrollContainer creates a new scroll container with an empty content container.
+func NewScrollContainer(opt *ScrollContainerOptions) *ScrollContainer {
+	sc := &ScrollContainer{
+		ScrolledEvent: &event.Event[*ScrollContainerScrolledEventArgs]{},
+
+		scrollStep: 16,
+
+		drawer: DefaultScrollContainerDrawer{
+			Color:         color.RGBA{230, 230, 230, 255},
+			ColorDisabled: color.RGBA{150, 150, 150, 255},
+		},
+	}
+	sc.self = sc
+
+	width := 100
+	height := 100
+
+	var thumbDrawer ButtonDrawer = &DefaultButtonDrawer{
+		Color:         color.RGBA{230, 230, 230, 255},
+		ColorPressed:  color.RGBA{200, 200, 200, 255},
+		ColorHovered:  color.RGBA{250, 250, 250, 255},
+		ColorDisabled: color.RGBA{150, 150, 150, 255},
+	}
+
+	var contentOptions ContainerOptions
+
+	if opt != nil {
+		if opt.Width.IsSet() {
+			width = opt.Width.Val()
+		}
+
+		if opt.Height.IsSet() {
+			height = opt.Height.Val()
+		}
+
+		if opt.ScrollStep.IsSet() {
+			sc.scrollStep = opt.ScrollStep.Val()
+		}
+
+		sc.verticalScrollbar = opt.VerticalScrollbar
+		sc.horizontalScrollbar = opt.HorizontalScrollbar
+
+		if opt.Drawer != nil {
+			sc.drawer = opt.Drawer
+		}
+
+		if opt.ThumbDrawer != nil {
+			thumbDrawer = opt.ThumbDrawer
+		}
+
+		contentOptions.Layout = opt.Layout
+	}
+
+	sc.SetDimensions(width, height)
+
+	sc.setUpComponent(opt)
+
+	sc.content = NewContainer(&contentOptions)
+	sc.content.setContainer(sc)
+
+	sc.verticalThumb = NewButton(&ButtonOptions{Width: option.Int(scrollbarThickness), Height: option.Int(scrollbarThickness), Drawer: thumbDrawer})
+	sc.verticalThumb.setContainer(sc)
+
+	sc.horizontalThumb = NewButton(&ButtonOptions{Width: option.Int(scrollbarThickness), Height: option.Int(scrollbarThickness), Drawer: thumbDrawer})
+	sc.horizontalThumb.setContainer(sc)
+
+	sc.ScrollTo(0, 0)
+
+	return sc
+}
+
+func (sc *ScrollContainer) setUpComponent(opt *ScrollContainerOptions) {
+	var componentOptions ComponentOptions
+
+	if opt != nil {
+		componentOptions = ComponentOptions{
+			Padding: opt.Padding,
+		}
+	}
+
+	sc.component.setUpComponent(&componentOptions)
+
+	// focus events of the content's components bubble up to the scroll container
+	sc.component.AddFocusedHandler(func(args *ComponentFocusedEventArgs) {
+		if args.Focused && args.Target() != sc {
+			sc.ScrollIntoView(args.Target())
+		}
+	})
+
+	// the wheel scrolls the innermost scroll container that can scroll, unless a component under the cursor used it
+	sc.MouseWheelEvent.AddBubblingDefaultHandler(func(args *ComponentMouseWheelEventArgs) {
+		if sc.disabled {
+			return
+		}
+
+		scrollX, scrollY := sc.ScrollPosition()
+		sc.ScrollBy(-int(args.WheelX*float64(sc.scrollStep)), -int(args.WheelY*float64(sc.scrollStep)))
+
+		if newScrollX, newScrollY := sc.ScrollPosition(); newScrollX != scrollX || newScrollY != scrollY {
+			args.StopPropagation()
+		}
+	})
+}
+
+// Content returns the scrolled content container.
+func (sc *ScrollContainer) Content() *Container {
+	return sc.content
+}
+
+// Components returns the components of the scrolled content.
+func (sc *ScrollContainer) Components() []Component {
+	return sc.content.Components()
+}
+
+// AddComponent adds a component to the scrolled content.
+func (sc *ScrollContainer) AddComponent(component Component) {
+	sc.content.AddComponent(component)
+}
+
+// AddComponents adds components to the scrolled content.
+func (sc *ScrollContainer) AddComponents(components ...Component) {
+	sc.content.AddComponents(components...)
+}
+
+func (sc *ScrollContainer) AddScrolledHandler(f ScrollContainerScrolledHandlerFunc) event.RemoveHandlerFunc {
+	return sc.ScrolledEvent.AddHandler(event.HandlerFunc[*ScrollContainerScrolledEventArgs](f))
+}
+
+// ScrollPosition returns the number of pixels the content is scrolled by horizontally and vertically.
+func (sc *ScrollContainer) ScrollPosition() (scrollX, scrollY int) {
+	return sc.scrollX, sc.scrollY
+}
+
+// MaxScroll returns the scroll position at which the content's bottom right corner is visible.
+func (sc *ScrollContainer) MaxScroll() (maxScrollX, maxScrollY int) {
+	viewportWidth, viewportHeight := sc.viewportSize()
+
+	return max(sc.content.WidthWithPadding()-viewportWidth, 0), max(sc.content.HeightWithPadding()-viewportHeight, 0)
+}
+
+// ScrollTo scrolls the content to the position, limited to the content's bounds.
+func (sc *ScrollContainer) ScrollTo(scrollX, scrollY int) {
+	maxScrollX, maxScrollY := sc.MaxScroll()
+	scrollX = min(max(scrollX, 0), maxScrollX)
+	scrollY = min(max(scrollY, 0), maxScrollY)
+
+	changed := scrollX != sc.scrollX || scrollY != sc.scrollY
+
+	sc.scrollX = scrollX
+	sc.scrollY = scrollY
+	sc.content.SetPosition(float64(sc.padding.Left-sc.scrollX), float64(sc.padding.Top-sc.scrollY))
+
+	if changed {
+		sc.updateScrollbars()
+
+		event.Fire(sc.eventManager, sc.ScrolledEvent, &ScrollContainerScrolledEventArgs{
+			ScrollContainer: sc,
+			ScrollX:         sc.scrollX,
+			ScrollY:         sc.scrollY,
+		})
+	}
+}
+
+// ScrollBy scrolls the content by the given number of pixels.
+func (sc *ScrollContainer) ScrollBy(dx, dy int) {
+	sc.ScrollTo(sc.scrollX+dx, sc.scrollY+dy)
+}
+
+// ScrollIntoView scrolls the content the least needed to make the component visible.
+func (sc *ScrollContainer) ScrollIntoView(component Component) {
+	viewportWidth, viewportHeight := sc.viewportSize()
+	width, height := component.Dimensions()
+
+	x := int(component.AbsPosX() - sc.content.AbsPosX())
+	y := int(component.AbsPosY() - sc.content.AbsPosY())
+
+	scrollX, scrollY := sc.scrollX, sc.scrollY
+
+	switch {
+	case x < scrollX:
+		scrollX = x
+	case x+width > scrollX+viewportWidth:
+		scrollX = x + width - viewportWidth
+	}
+
+	switch {
+	case y < scrollY:
+		scrollY = y
+	case y+height > scrollY+viewportHeight:
+		scrollY = y + height - viewportHeight
+	}
+
+	sc.ScrollTo(scrollX, scrollY)
+}
+
+// viewportSize returns the size of the area the content is visible in, without the scrollbars.
+func (sc *ScrollContainer) viewportSize() (int, int) {
+	width, height := sc.width, sc.height
+
+	if sc.verticalScrollbar {
+		width -= scrollbarThickness
+	}
+
+	if sc.horizontalScrollbar {
+		height -= scrollbarThickness
+	}
+
+	return max(width, 0), max(height, 0)
+}
+
+// viewportRect returns the area the content is visible in, in the image coordinates.
+func (sc *ScrollContainer) viewportRect() image.Rectangle {
+	width, height := sc.viewportSize()
+
+	return image.Rect(sc.padding.Left, sc.padding.Top, sc.padding.Left+width, sc.padding.Top+height)
+}
+
+// verticalTrackRect returns the area of the vertical scrollbar in the image coordinates.
+func (sc *ScrollContainer) verticalTrackRect() image.Rectangle {
+	if !sc.verticalScrollbar {
+		return image.Rectangle{}
+	}
+
+	viewport := sc.viewportRect()
+
+	return image.Rect(viewport.Max.X, viewport.Min.Y, viewport.Max.X+scrollbarThickness, viewport.Max.Y)
+}
+
+// horizontalTrackRect returns the area of the horizontal scrollbar in the image coordinates.
+func (sc *ScrollContainer) horizontalTrackRect() image.Rectangle {
+	if !sc.horizontalScrollbar {
+		return image.Rectangle{}
+	}
+
+	viewport := sc.viewportRect()
+
+	return image.Rect(viewport.Min.X, viewport.Max.Y, viewport.Max.X, viewport.Max.Y+scrollbarThickness)
+}
+
+// updateScrollbars resizes and moves the thumbs to match the content size and the scroll position.
+// Thumbs are hidden when the content fits in the viewport.
+func (sc *ScrollContainer) updateScrollbars() {
+	if sc.verticalThumb == nil || sc.horizontalThumb == nil {
+		return
+	}
+
+	maxScrollX, maxScrollY := sc.MaxScroll()
+	viewportWidth, viewportHeight := sc.viewportSize()
+
+	sc.verticalThumb.SetHidden(!sc.verticalScrollbar || maxScrollY == 0)
+	if !sc.verticalThumb.hidden {
+		thumbHeight := thumbLength(viewportHeight, viewportHeight+maxScrollY)
+		if sc.verticalThumb.HeightWithPadding() != thumbHeight {
+			sc.verticalThumb.SetDimensions(scrollbarThickness, thumbHeight)
+		}
+
+		sc.verticalThumb.SetPosition(float64(sc.padding.Left+viewportWidth), float64(sc.padding.Top+(viewportHeight-thumbHeight)*sc.scrollY/maxScrollY))
+	}
+
+	sc.horizontalThumb.SetHidden(!sc.horizontalScrollbar || maxScrollX == 0)
+	if !sc.horizontalThumb.hidden {
+		thumbWidth := thumbLength(viewportWidth, viewportWidth+maxScrollX)
+		if sc.horizontalThumb.WidthWithPadding() != thumbWidth {
+			sc.horizontalThumb.SetDimensions(thumbWidth, scrollbarThickness)
+		}
+
+		sc.horizontalThumb.SetPosition(float64(sc.padding.Left+(viewportWidth-thumbWidth)*sc.scrollX/maxScrollX), float64(sc.padding.Top+viewportHeight))
+	}
+}
+
+// thumbLength returns the length of a scrollbar thumb proportional to the visible part of the content.
+func thumbLength(viewportLength, contentLength int) int {
+	return min(max(viewportLength*viewportLength/contentLength, scrollbarThickness), viewportLength)
+}
+
+// dragThumbs scrolls the content while a scrollbar thumb is dragged.
+func (sc *ScrollContainer) dragThumbs(in input.InputSource) {
+	if sc.disabled || !in.MouseButtonPressed(ebiten.MouseButtonLeft) {
+		sc.draggedThumb = nil
+		return
+	}
+
+	cursorPosX, cursorPosY := in.CursorPosition()
+
+	if in.MouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		for _, thumb := range []*Button{sc.verticalThumb, sc.horizontalThumb} {
+			if !thumb.hidden && image.Pt(cursorPosX, cursorPosY).In(thumb.rect) {
+				sc.draggedThumb = thumb
+				sc.dragOffset = cursorPosX - int(thumb.absPosX)
+				if thumb == sc.verticalThumb {
+					sc.dragOffset = cursorPosY - int(thumb.absPosY)
+				}
+			}
+		}
+	}
+
+	maxScrollX, maxScrollY := sc.MaxScroll()
+	viewportWidth, viewportHeight := sc.viewportSize()
+
+	switch sc.draggedThumb {
+	case sc.verticalThumb:
+		if trackLength := viewportHeight - sc.verticalThumb.HeightWithPadding(); trackLength > 0 {
+			thumbPos := cursorPosY - sc.dragOffset - int(sc.absPosY) - sc.padding.Top
+			sc.ScrollTo(sc.scrollX, thumbPos*maxScrollY/trackLength)
+		}
+	case sc.horizontalThumb:
+		if trackLength := viewportWidth - sc.horizontalThumb.WidthWithPadding(); trackLength > 0 {
+			thumbPos := cursorPosX - sc.dragOffset - int(sc.absPosX) - sc.padding.Left
+			sc.ScrollTo(thumbPos*maxScrollX/trackLength, sc.scrollY)
+		}
+	}
+}
+
+// setContainer sets the component's container.
+func (sc *ScrollContainer) setContainer(container container) {
+	sc.component.setContainer(container)
+	sc.SetEventManager(container.EventManager())
+	sc.RecalculateAbsPosition()
+}
+
+// growsWithComponents returns false, as the content grows past the viewport instead.
+func (sc *ScrollContainer) growsWithComponents() bool {
+	return false
+}
+
+// SetEventManager sets the scroll container's, its content's and scrollbars' event managers.
+func (sc *ScrollContainer) SetEventManager(eventManager *event.Manager) {
+	sc.component.SetEventManager(eventManager)
+	sc.content.SetEventManager(eventManager)
+	sc.verticalThumb.SetEventManager(eventManager)
+	sc.horizontalThumb.SetEventManager(eventManager)
+}
+
+// SetDisabled sets the scroll container's and its content disabled states.
+func (sc *ScrollContainer) SetDisabled(disabled bool) {
+	sc.content.SetDisabled(disabled)
+	sc.verticalThumb.SetDisabled(disabled)
+	sc.horizontalThumb.SetDisabled(disabled)
+	sc.component.SetDisabled(disabled)
+}
+
+// SetPosX sets the scroll container's position X.
+func (sc *ScrollContainer) SetPosX(posX float64) {
+	sc.component.SetPosX(posX)
+	sc.recalculateChildrenAbsPosition()
+}
+
+// SetPosY sets the scroll container's position Y.
+func (sc *ScrollContainer) SetPosY(posY float64) {
+	sc.component.SetPosY(posY)
+	sc.recalculateChildrenAbsPosition()
+}
+
+// SetPosition sets the scroll container's position (x and y).
+func (sc *ScrollContainer) SetPosition(posX, posY float64) {
+	sc.component.SetPosition(posX, posY)
+	sc.recalculateChildrenAbsPosition()
+}
+
+func (sc *ScrollContainer) RecalculateAbsPosition() {
+	sc.component.RecalculateAbsPosition()
+	sc.recalculateChildrenAbsPosition()
+}
+
+func (sc *ScrollContainer) recalculateChildrenAbsPosition() {
+	if sc.content == nil {
+		return
+	}
+
+	sc.content.RecalculateAbsPosition()
+	sc.verticalThumb.RecalculateAbsPosition()
+	sc.horizontalThumb.RecalculateAbsPosition()
+}
+
+// SetBackgroundColor sets the scroll container's background color
+func (sc *ScrollContainer) SetBackgroundColor(color color.RGBA) {
+	sc.backgroundColor = color
+}
+
+// GetBackgroundColor gets the scroll container's background color
+func (sc *ScrollContainer) GetBackgroundColor() color.RGBA {
+	return sc.backgroundColor
+}
+
+// componentAt returns the topmost visible component of the content at the point,
+// or the scroll container itself if the point is over the scrollbars or an empty part of the viewport.
+func (sc *ScrollContainer) componentAt(p image.Point) Component {
+	if sc.hidden || !p.In(sc.rect) {
+		return nil
+	}
+
+	if p.In(sc.viewportRect().Add(sc.rect.Min)) {
+		if hit := sc.content.componentAt(p); hit != nil {
+			return hit
+		}
+	}
+
+	return sc
+}
+
+// FireEvents fires the content's and scrollbars' events.
+// The content doesn't see the mouse cursor while it's outside of the viewport, unless it's dragging from inside of it.
+func (sc *ScrollContainer) FireEvents(in input.InputSource) {
+	sc.ScrollTo(sc.scrollX, sc.scrollY)
+	sc.updateScrollbars()
+
+	cursorPosX, cursorPosY := in.CursorPosition()
+	cursor := image.Pt(cursorPosX, cursorPosY)
+	cursorInViewport := cursor.In(sc.viewportRect().Add(sc.rect.Min))
+
+	sc.lastUpdateCursorEntered = cursor.In(sc.rect)
+
+	mouseButtonPressed := in.MouseButtonPressed(ebiten.MouseButtonLeft) || in.MouseButtonPressed(ebiten.MouseButtonRight)
+	mouseButtonJustPressed := in.MouseButtonJustPressed(ebiten.MouseButtonLeft) || in.MouseButtonJustPressed(ebiten.MouseButtonRight)
+
+	if mouseButtonJustPressed && cursorInViewport {
+		sc.cursorCaptured = true
+	}
+
+	// the cursor is hidden from the content outside of the viewport, so the content scrolled out of view can't be hovered or clicked
+	contentInput := in
+	if !cursorInViewport && !sc.cursorCaptured {
+		contentInput = input.CoveredInputSource{InputSource: in}
+	}
+
+	sc.content.FireEvents(contentInput)
+
+	if !mouseButtonPressed {
+		sc.cursorCaptured = false
+	}
+
+	for _, thumb := range []*Button{sc.verticalThumb, sc.horizontalThumb} {
+		if !thumb.hidden {
+			thumb.FireEvents(in)
+		}
+	}
+
+	sc.dragThumbs(in)
+}
+
+// Draw draws the scrollbars and the part of the content visible in the viewport.
+func (sc *ScrollContainer) Draw() *ebiten.Image {
+	if sc.hidden {
+		return sc.image
+	}
+
+	sc.updateScrollbars()
+
+	sc.drawer.Draw(sc)
+
+	viewport := sc.image.SubImage(sc.viewportRect()).(*ebiten.Image)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(sc.content.Position())
+	viewport.DrawImage(sc.content.Draw(), op)
+
+	for _, thumb := range []*Button{sc.verticalThumb, sc.horizontalThumb} {
+		if !thumb.hidden {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(thumb.Position())
+			sc.image.DrawImage(thumb.Draw(), op)
+		}
+	}
+
+	sc.component.Draw()
+
+	return sc.image
+}
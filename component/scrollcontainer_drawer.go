@@ -0,0 +1,72 @@
+package component
+
+import (
+	"image"
+	"image/color"
+
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+type ScrollContainerDrawer interface {
+	Draw(scrollContainer *ScrollContainer) *ebiten.Image
+}
+
+// DefaultScrollContainerDrawer fills the scroll container with its background color
+// and draws the scrollbar tracks in the style of the slider.
+type DefaultScrollContainerDrawer struct {
+	Color         color.RGBA
+	ColorDisabled color.RGBA
+}
+
+func (d DefaultScrollContainerDrawer) Draw(scrollContainer *ScrollContainer) *ebiten.Image {
+	if scrollContainer.disabled {
+		scrollContainer.image.WritePixels(d.draw(scrollContainer, d.ColorDisabled))
+	} else {
+		scrollContainer.image.WritePixels(d.draw(scrollContainer, d.Color))
+	}
+
+	return scrollContainer.image
+}
+
+func (d DefaultScrollContainerDrawer) draw(scrollContainer *ScrollContainer, trackColor color.RGBA) []byte {
+	arr := make([]byte, scrollContainer.pixelRows*scrollContainer.pixelCols)
+	backgroundColor := scrollContainer.backgroundColor
+
+	for i := 0; i < len(arr); i += 4 {
+		arr[i] = backgroundColor.R
+		arr[i+1] = backgroundColor.G
+		arr[i+2] = backgroundColor.B
+		arr[i+3] = backgroundColor.A
+	}
+
+	d.drawTrack(arr, scrollContainer, scrollContainer.verticalTrackRect(), trackColor)
+	d.drawTrack(arr, scrollContainer, scrollContainer.horizontalTrackRect(), trackColor)
+
+	return arr
+}
+
+// drawTrack draws the border of the track with cut corners.
+func (d DefaultScrollContainerDrawer) drawTrack(arr []byte, scrollContainer *ScrollContainer, track image.Rectangle, trackColor color.RGBA) {
+	if track.Empty() {
+		return
+	}
+
+	for rowId := track.Min.Y; rowId < track.Max.Y; rowId++ {
+		rowNumber := scrollContainer.pixelCols * rowId
+
+		for x := track.Min.X; x < track.Max.X; x++ {
+			isHorizontalBorder := rowId == track.Min.Y || rowId == track.Max.Y-1
+			isVerticalBorder := x == track.Min.X || x == track.Max.X-1
+
+			if isHorizontalBorder && isVerticalBorder || !isHorizontalBorder && !isVerticalBorder {
+				continue
+			}
+
+			colId := x * 4
+			arr[colId+rowNumber] = trackColor.R
+			arr[colId+1+rowNumber] = trackColor.G
+			arr[colId+2+rowNumber] = trackColor.B
+			arr[colId+3+rowNumber] = trackColor.A
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+// newTestScrollContainer creates a 50x40 scroll container with a vertical scrollbar and a list of 5 buttons,
+// each 15 pixels high with 5 pixels gaps, and puts it into a root container.
+func newTestScrollContainer(t *testing.T) (*Container, *ScrollContainer, []*Button) {
+	t.Helper()
+
+	sc := NewScrollContainer(&ScrollContainerOptions{
+		Layout:            &VerticalListLayout{RowGap: 5},
+		Width:             option.Int(50),
+		Height:            option.Int(40),
+		VerticalScrollbar: true,
+	})
+	root := newTestRootContainer(sc)
+
+	var buttons []*Button
+	for i := 0; i < 5; i++ {
+		b := NewButton(&ButtonOptions{Width: option.Int(40)})
+		sc.AddComponent(b)
+		buttons = append(buttons, b)
+	}
+
+	return root, sc, buttons
+}
+
+func TestScrollContainer_Dimensions(t *testing.T) {
+	is := is.New(t)
+
+	_, sc, _ := newTestScrollContainer(t)
+
+	is.Equal(sc.Width(), 50)
+	is.Equal(sc.Height(), 40) // doesn't grow with the content
+
+	maxScrollX, maxScrollY := sc.MaxScroll()
+	is.Equal(maxScrollX, 0)
+	is.Equal(maxScrollY, 5*20-40)
+}
+
+func TestScrollContainer_MouseWheel(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, sc, buttons := newTestScrollContainer(t)
+
+	var scrolledY []int
+	sc.AddScrolledHandler(func(args *ScrollContainerScrolledEventArgs) {
+		scrolledY = append(scrolledY, args.ScrollY)
+	})
+
+	scrollWheel(t, root, in, 10, 10, 0, -1)
+	_, scrollY := sc.ScrollPosition()
+	is.Equal(scrollY, 16)
+	is.Equal(buttons[0].AbsPosY(), float64(-16)) // the content moves with the scroll position
+
+	scrollWheel(t, root, in, 10, 10, 0, -10)
+	_, scrollY = sc.ScrollPosition()
+	is.Equal(scrollY, 60) // limited to the content's bounds
+
+	scrollWheel(t, root, in, 10, 10, 0, 10)
+	_, scrollY = sc.ScrollPosition()
+	is.Equal(scrollY, 0)
+
+	is.Equal(scrolledY, []int{16, 60, 0})
+}
+
+func TestScrollContainer_HitTesting(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, sc, buttons := newTestScrollContainer(t)
+
+	clicked := make([]int, len(buttons))
+	for i, b := range buttons {
+		i := i
+		b.AddClickedHandler(func(args *ButtonClickedEventArgs) {
+			clicked[i]++
+		})
+	}
+
+	click := func(x, y int) {
+		in.MoveCursor(x, y)
+		in.PressMouseButton(ebiten.MouseButtonLeft)
+		in.Update()
+		root.FireEvents(in)
+		root.eventManager.HandleFired()
+
+		in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+		in.Update()
+		root.FireEvents(in)
+		root.eventManager.HandleFired()
+	}
+
+	click(10, 45) // the third button is below the viewport
+	is.Equal(clicked, []int{0, 0, 0, 0, 0})
+
+	sc.ScrollTo(0, 40)
+	click(10, 5) // the third button is scrolled to the top of the viewport
+	is.Equal(clicked, []int{0, 0, 1, 0, 0})
+
+	click(10, -15) // the second button is above the viewport
+	is.Equal(clicked, []int{0, 0, 1, 0, 0})
+}
+
+func TestScrollContainer_ScrollIntoView(t *testing.T) {
+	is := is.New(t)
+
+	root, sc, buttons := newTestScrollContainer(t)
+
+	buttons[3].SetFocused(true)
+	root.eventManager.HandleFired()
+	_, scrollY := sc.ScrollPosition()
+	is.Equal(scrollY, 3*20+15-40) // the button's bottom edge at the bottom of the viewport
+
+	buttons[0].SetFocused(true)
+	root.eventManager.HandleFired()
+	_, scrollY = sc.ScrollPosition()
+	is.Equal(scrollY, 0)
+}
+
+func TestScrollContainer_DragThumb(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, sc, _ := newTestScrollContainer(t)
+	sc.Draw()
+
+	thumbX, thumbY := sc.verticalThumb.AbsPosition()
+	is.Equal(sc.verticalThumb.HeightWithPadding(), 40*40/100)
+
+	in.MoveCursor(int(thumbX)+1, int(thumbY)+1)
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	in.Update()
+	root.FireEvents(in)
+
+	in.MoveCursor(int(thumbX)+1, int(thumbY)+1+12)
+	in.Update()
+	root.FireEvents(in)
+
+	_, scrollY := sc.ScrollPosition()
+	is.Equal(scrollY, 12*60/(40-16)) // the track is 24 pixels long for the 60 pixels of scrolling
+
+	in.MoveCursor(int(thumbX)+1, 1000)
+	in.Update()
+	root.FireEvents(in)
+
+	_, scrollY = sc.ScrollPosition()
+	is.Equal(scrollY, 60)
+}
+
+func TestScrollContainer_MouseWheel_handledByComponent(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, sc, _ := newTestScrollContainer(t)
+	slider := NewSlider(&SliderOptions{
+		Min:          option.Float(0),
+		Max:          option.Float(10),
+		Step:         option.Float(1),
+		DefaultValue: option.Float(5),
+	})
+	sc.AddComponent(slider)
+	sc.ScrollIntoView(slider)
+
+	_, scrollY := sc.ScrollPosition()
+
+	x, y := slider.AbsPosition()
+	scrollWheel(t, root, in, int(x)+10, int(y)+1, 0, 1)
+
+	is.Equal(slider.GetValue(), 6.0)
+	_, scrollYAfter := sc.ScrollPosition()
+	is.Equal(scrollYAfter, scrollY) // the slider kept the wheel from the scroll container
+
+	// the slider at its max can't use the wheel, so the scroll container scrolls
+	slider.SetToMax()
+	scrollWheel(t, root, in, int(x)+10, int(y)+1, 0, 1)
+	is.Equal(slider.GetValue(), 10.0)
+	_, scrollYAfter = sc.ScrollPosition()
+	is.Equal(scrollYAfter, scrollY-sc.scrollStep)
+}
+
+func TestScrollContainer_MouseWheel_textInput(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	root, sc, _ := newTestScrollContainer(t)
+	ti := NewTextInput(&TextInputOptions{Width: option.Int(40)})
+	sc.AddComponent(ti)
+	sc.ScrollIntoView(ti)
+
+	_, scrollY := sc.ScrollPosition()
+	x, y := ti.AbsPosition()
+
+	// the text fits the input, so the scroll container scrolls
+	ti.SetValue("short")
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 1)
+	is.Equal(ti.scrollOffset, 0)
+	_, scrollYAfter := sc.ScrollPosition()
+	is.Equal(scrollYAfter, scrollY-sc.scrollStep)
+
+	sc.ScrollIntoView(ti)
+	x, y = ti.AbsPosition()
+
+	// the overflowing text is scrolled instead
+	ti.SetValue("a text that is much longer than the input")
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, -1)
+	is.Equal(ti.scrollOffset, textInputWheelScrollSpeed)
+	_, scrollYAfter = sc.ScrollPosition()
+	is.Equal(scrollYAfter, scrollY)
+
+	// preventing the default keeps both from scrolling
+	ti.AddMouseWheelHandler(func(args *ComponentMouseWheelEventArgs) {
+		args.PreventDefault()
+	})
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, -1)
+	is.Equal(ti.scrollOffset, textInputWheelScrollSpeed)
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 1)
+	is.Equal(ti.scrollOffset, textInputWheelScrollSpeed)
+	_, scrollYAfter = sc.ScrollPosition()
+	is.Equal(scrollYAfter, scrollY)
+}
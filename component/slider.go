@@ -3,10 +3,12 @@ package component
 import (
 	"image/color"
 	"math"
+	"time"
 
 	"github.com/fglo/chopstiqs/event"
 	"github.com/fglo/chopstiqs/input"
 	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/tween"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -27,11 +29,17 @@ type Slider struct {
 
 	sliding bool
 
-	SlidedEvent *event.Event
+	handleAnimationDuration time.Duration
+	handleEasing            tween.Easing
+	// handleAnimator is the animator playing the handle's tween
+	handleAnimator *tween.Animator
+	handleTween    *tween.Tween
 
-	PressedEvent  *event.Event
-	ReleasedEvent *event.Event
-	ClickedEvent  *event.Event
+	SlidedEvent *event.Event[*SliderSlidedEventArgs]
+
+	PressedEvent  *event.Event[*SliderPressedEventArgs]
+	ReleasedEvent *event.Event[*SliderReleasedEventArgs]
+	ClickedEvent  *event.Event[*SliderClickedEventArgs]
 
 	firstPixelRowId       int
 	secondPixelRowId      int
@@ -57,9 +65,16 @@ type SliderOptions struct {
 	Height option.OptInt
 
 	Padding *Padding
+	Tooltip *Tooltip
 
 	Drawer       SliderDrawer
 	HandleDrawer ButtonDrawer
+
+	// HandleAnimationDuration is the duration of the handle's movement to a value set with Set, Increment, Decrement, SetToMin or SetToMax.
+	// The handle follows the mouse cursor without an animation. If not set, the handle is moved immediately.
+	HandleAnimationDuration time.Duration
+	// HandleEasing is the easing of the handle's movement. If not set, tween.OutQuad is used.
+	HandleEasing tween.Easing
 }
 
 type SliderSlidedEventArgs struct {
@@ -91,16 +106,17 @@ type SliderClickedHandlerFunc func(args *SliderClickedEventArgs)
 
 func NewSlider(opt *SliderOptions) *Slider {
 	s := &Slider{
-		SlidedEvent:   &event.Event{},
-		PressedEvent:  &event.Event{},
-		ReleasedEvent: &event.Event{},
-		ClickedEvent:  &event.Event{},
+		SlidedEvent:   &event.Event[*SliderSlidedEventArgs]{},
+		PressedEvent:  &event.Event[*SliderPressedEventArgs]{},
+		ReleasedEvent: &event.Event[*SliderReleasedEventArgs]{},
+		ClickedEvent:  &event.Event[*SliderClickedEventArgs]{},
 
 		drawer: DefaultSliderDrawer{
 			Color:         color.RGBA{230, 230, 230, 255},
 			ColorPressed:  color.RGBA{230, 230, 230, 255},
 			ColorHovered:  color.RGBA{230, 230, 230, 255},
 			ColorDisabled: color.RGBA{150, 150, 150, 255},
+			ColorFocused:  color.RGBA{90, 160, 250, 255},
 		},
 		handleDrawer: &DefaultButtonDrawer{
 			Color:         color.RGBA{230, 230, 230, 255},
@@ -151,6 +167,13 @@ func NewSlider(opt *SliderOptions) *Slider {
 		if opt.HandleDrawer != nil {
 			s.handleDrawer = opt.HandleDrawer
 		}
+
+		s.handleAnimationDuration = opt.HandleAnimationDuration
+		s.handleEasing = opt.HandleEasing
+	}
+
+	if s.handleEasing == nil {
+		s.handleEasing = tween.OutQuad
 	}
 
 	steps := math.Round((s.max-s.min)/s.step) + 1
@@ -181,48 +204,75 @@ func (s *Slider) setUpComponent(opt *SliderOptions) {
 	if opt != nil {
 		componentOptions = ComponentOptions{
 			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
 		}
 	}
 
 	s.component.setUpComponent(&componentOptions)
+	s.focusable = true
 
-	s.component.AddCursorEnterHandler(func(args *ComponentCursorEnterEventArgs) {
+	s.CursorEnterEvent.AddDefaultHandler(func(args *ComponentCursorEnterEventArgs) {
 		if !s.disabled {
 			s.hovering = true
 		}
 	})
 
-	s.component.AddCursorExitHandler(func(args *ComponentCursorExitEventArgs) {
+	s.CursorExitEvent.AddDefaultHandler(func(args *ComponentCursorExitEventArgs) {
 		s.hovering = false
 	})
 
-	s.component.AddMouseButtonPressedHandler(func(args *ComponentMouseButtonPressedEventArgs) {
+	s.MouseButtonPressedEvent.AddDefaultHandler(func(args *ComponentMouseButtonPressedEventArgs) {
 		if !s.disabled && args.Button == ebiten.MouseButtonLeft {
 			s.pressed = true
 			s.sliding = true
 
 			if s.handle.posX >= 0 && s.handle.posX <= float64(s.width) {
-				s.updateHandlePosition()
+				s.updateHandlePosition(args.CursorPosX)
 			}
 
-			s.eventManager.Fire(s.PressedEvent, &SliderPressedEventArgs{
+			event.Fire(s.eventManager, s.PressedEvent, &SliderPressedEventArgs{
 				Slider: s,
 			})
 		}
 	})
 
-	s.component.AddMouseButtonReleasedHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+	s.MouseWheelEvent.AddDefaultHandler(func(args *ComponentMouseWheelEventArgs) {
+		if s.disabled {
+			return
+		}
+
+		wheel := args.WheelY
+		if wheel == 0 {
+			wheel = args.WheelX
+		}
+
+		value := s.value
+
+		switch {
+		case wheel > 0:
+			s.Increment()
+		case wheel < 0:
+			s.Decrement()
+		}
+
+		// the wheel reaches the scroll containers the slider is in, unless it changed the value
+		if s.value != value {
+			args.StopPropagation()
+		}
+	})
+
+	s.MouseButtonReleasedEvent.AddDefaultHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
 		if s.pressed && args.Button == ebiten.MouseButtonLeft {
 			s.pressed = false
 			s.sliding = false
 
-			s.eventManager.Fire(s.ReleasedEvent, &SliderReleasedEventArgs{
+			event.Fire(s.eventManager, s.ReleasedEvent, &SliderReleasedEventArgs{
 				Slider: s,
 				Inside: args.Inside,
 			})
 
 			if !s.disabled {
-				s.eventManager.Fire(s.ClickedEvent, &SliderClickedEventArgs{
+				event.Fire(s.eventManager, s.ClickedEvent, &SliderClickedEventArgs{
 					Slider: s,
 				})
 			}
@@ -276,12 +326,8 @@ func (s *Slider) SetDisabled(disabled bool) {
 	s.component.SetDisabled(disabled)
 }
 
-func (s *Slider) AddSlidedHandler(f SliderSlidedHandlerFunc) *Slider {
-	s.SlidedEvent.AddHandler(func(args interface{}) {
-		f(args.(*SliderSlidedEventArgs))
-	})
-
-	return s
+func (s *Slider) AddSlidedHandler(f SliderSlidedHandlerFunc) event.RemoveHandlerFunc {
+	return s.SlidedEvent.AddHandler(event.HandlerFunc[*SliderSlidedEventArgs](f))
 }
 
 func (s *Slider) GetValue() float64 {
@@ -289,9 +335,9 @@ func (s *Slider) GetValue() float64 {
 }
 
 // FireEvents checks if the mouse cursor is inside the component and fires events accordingly.
-func (s *Slider) FireEvents() {
-	s.component.FireEvents()
-	s.handle.FireEvents()
+func (s *Slider) FireEvents(in input.InputSource) {
+	s.component.FireEvents(in)
+	s.handle.FireEvents(in)
 }
 
 func (s *Slider) Draw() *ebiten.Image {
@@ -314,24 +360,63 @@ func (s *Slider) Draw() *ebiten.Image {
 func (s *Slider) Set(value float64) {
 	prevValue := s.value
 	s.value = value
-	s.handle.SetPosition(s.calcHandlePosition(), 0)
+	s.moveHandle(s.calcHandlePosition())
 	s.fireEventOnChange(prevValue)
 }
 
 func (s *Slider) SetToMin() {
 	prevValue := s.value
 	s.value = s.min
-	s.handle.SetPosX(2)
+	s.moveHandle(2)
 	s.fireEventOnChange(prevValue)
 }
 
 func (s *Slider) SetToMax() {
 	prevValue := s.value
 	s.value = s.max
-	s.handle.SetPosX(float64(s.width-s.handle.width) - 2)
+	s.moveHandle(float64(s.width-s.handle.width) - 2)
 	s.fireEventOnChange(prevValue)
 }
 
+// Increment increases the slider's value by its step, up to the max value.
+func (s *Slider) Increment() {
+	if s.value+s.step >= s.max {
+		s.SetToMax()
+		return
+	}
+
+	s.Set(s.value + s.step)
+}
+
+// Decrement decreases the slider's value by its step, down to the min value.
+func (s *Slider) Decrement() {
+	if s.value-s.step <= s.min {
+		s.SetToMin()
+		return
+	}
+
+	s.Set(s.value - s.step)
+}
+
+// moveHandle moves the handle to the position x, animating the movement with the root container's animator,
+// unless the handle follows the mouse cursor or the slider isn't in a tree with an animator.
+func (s *Slider) moveHandle(x float64) {
+	if s.handleTween != nil {
+		s.handleAnimator.Stop(s.handleTween)
+		s.handleTween = nil
+	}
+
+	animator := s.component.animator()
+	if s.handleAnimationDuration <= 0 || s.sliding || animator == nil {
+		s.handle.SetPosX(x)
+		return
+	}
+
+	s.handleAnimator = animator
+	s.handleTween = MoveTo(s.handle, x, 0, s.handleAnimationDuration, s.handleEasing)
+	s.handleAnimator.Play(s.handleTween)
+}
+
 func (s *Slider) fireEventOnChange(prevValue float64) {
 	change := math.Round((s.value - prevValue) / s.step)
 	if math.Abs(change) < s.step {
@@ -339,7 +424,7 @@ func (s *Slider) fireEventOnChange(prevValue float64) {
 	}
 
 	if change != 0 {
-		s.eventManager.Fire(s.SlidedEvent, &SliderSlidedEventArgs{
+		event.Fire(s.eventManager, s.SlidedEvent, &SliderSlidedEventArgs{
 			Slider: s,
 			Change: change,
 			Value:  s.value,
@@ -347,9 +432,7 @@ func (s *Slider) fireEventOnChange(prevValue float64) {
 	}
 }
 
-func (s *Slider) updateHandlePosition() {
-	currCursorPosX := input.CursorPosX
-
+func (s *Slider) updateHandlePosition(currCursorPosX int) {
 	switch {
 	case currCursorPosX >= s.rect.Max.X:
 		s.SetToMax()
@@ -15,6 +15,8 @@ type DefaultSliderDrawer struct {
 	ColorPressed  color.RGBA
 	ColorHovered  color.RGBA
 	ColorDisabled color.RGBA
+	// ColorFocused is the color of the focused slider's border. If it's transparent, the focus isn't drawn.
+	ColorFocused color.RGBA
 }
 
 func (d DefaultSliderDrawer) Draw(slider *Slider) *ebiten.Image {
@@ -34,6 +36,7 @@ func (d DefaultSliderDrawer) Draw(slider *Slider) *ebiten.Image {
 func (d *DefaultSliderDrawer) draw(slider *Slider) []byte {
 	arr := make([]byte, slider.pixelRows*slider.pixelCols)
 	backgroundColor := slider.container.GetBackgroundColor()
+	borderColor := d.borderColor(slider, d.Color)
 
 	for rowId := slider.firstPixelRowId; rowId <= slider.lastPixelRowId; rowId++ {
 		rowNumber := slider.pixelCols * rowId
@@ -44,7 +47,12 @@ func (d *DefaultSliderDrawer) draw(slider *Slider) []byte {
 				arr[colId+1+rowNumber] = backgroundColor.G
 				arr[colId+2+rowNumber] = backgroundColor.B
 				arr[colId+3+rowNumber] = backgroundColor.A
-			} else if d.isBorder(slider, rowId, colId) || (d.isColored(slider, rowId, colId) && colId <= int(slider.handle.posX)*4) {
+			} else if d.isBorder(slider, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else if d.isColored(slider, rowId, colId) && colId <= int(slider.handle.posX)*4 {
 				arr[colId+rowNumber] = d.Color.R
 				arr[colId+1+rowNumber] = d.Color.G
 				arr[colId+2+rowNumber] = d.Color.B
@@ -64,6 +72,7 @@ func (d *DefaultSliderDrawer) draw(slider *Slider) []byte {
 func (d *DefaultSliderDrawer) drawPressed(slider *Slider) []byte {
 	arr := make([]byte, slider.pixelRows*slider.pixelCols)
 	backgroundColor := slider.container.GetBackgroundColor()
+	borderColor := d.borderColor(slider, d.ColorPressed)
 
 	for rowId := slider.firstPixelRowId; rowId <= slider.lastPixelRowId; rowId++ {
 		rowNumber := slider.pixelCols * rowId
@@ -74,7 +83,12 @@ func (d *DefaultSliderDrawer) drawPressed(slider *Slider) []byte {
 				arr[colId+1+rowNumber] = backgroundColor.G
 				arr[colId+2+rowNumber] = backgroundColor.B
 				arr[colId+3+rowNumber] = backgroundColor.A
-			} else if d.isBorder(slider, rowId, colId) || (d.isColored(slider, rowId, colId) && colId <= int(slider.handle.posX)*4) {
+			} else if d.isBorder(slider, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else if d.isColored(slider, rowId, colId) && colId <= int(slider.handle.posX)*4 {
 				arr[colId+rowNumber] = d.ColorPressed.R
 				arr[colId+1+rowNumber] = d.ColorPressed.G
 				arr[colId+2+rowNumber] = d.ColorPressed.B
@@ -94,6 +108,7 @@ func (d *DefaultSliderDrawer) drawPressed(slider *Slider) []byte {
 func (d *DefaultSliderDrawer) drawHovered(slider *Slider) []byte {
 	arr := make([]byte, slider.pixelRows*slider.pixelCols)
 	backgroundColor := slider.container.GetBackgroundColor()
+	borderColor := d.borderColor(slider, d.ColorHovered)
 
 	for rowId := slider.firstPixelRowId; rowId <= slider.lastPixelRowId; rowId++ {
 		rowNumber := slider.pixelCols * rowId
@@ -104,7 +119,12 @@ func (d *DefaultSliderDrawer) drawHovered(slider *Slider) []byte {
 				arr[colId+1+rowNumber] = backgroundColor.G
 				arr[colId+2+rowNumber] = backgroundColor.B
 				arr[colId+3+rowNumber] = backgroundColor.A
-			} else if d.isBorder(slider, rowId, colId) || (d.isColored(slider, rowId, colId) && colId <= int(slider.handle.posX)*4) {
+			} else if d.isBorder(slider, rowId, colId) {
+				arr[colId+rowNumber] = borderColor.R
+				arr[colId+1+rowNumber] = borderColor.G
+				arr[colId+2+rowNumber] = borderColor.B
+				arr[colId+3+rowNumber] = borderColor.A
+			} else if d.isColored(slider, rowId, colId) && colId <= int(slider.handle.posX)*4 {
 				arr[colId+rowNumber] = d.ColorHovered.R
 				arr[colId+1+rowNumber] = d.ColorHovered.G
 				arr[colId+2+rowNumber] = d.ColorHovered.B
@@ -151,6 +171,15 @@ func (d *DefaultSliderDrawer) drawDisabled(slider *Slider) []byte {
 	return arr
 }
 
+// borderColor returns ColorFocused while the slider is focused, or the color of the slider's state otherwise.
+func (d DefaultSliderDrawer) borderColor(slider *Slider, stateColor color.RGBA) color.RGBA {
+	if slider.focused && d.ColorFocused.A > 0 {
+		return d.ColorFocused
+	}
+
+	return stateColor
+}
+
 func (d DefaultSliderDrawer) isCorner(slider *Slider, rowId, colId int) bool {
 	return (rowId == slider.firstPixelRowId || rowId == slider.lastPixelRowId) && (colId == slider.firstPixelColId || colId == slider.lastPixelColId)
 }
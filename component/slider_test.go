@@ -0,0 +1,83 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/fglo/chopstiqs/tween"
+	"github.com/matryer/is"
+)
+
+func TestSlider_MouseWheel(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	slider := NewSlider(&SliderOptions{
+		Min:          option.Float(0),
+		Max:          option.Float(10),
+		Step:         option.Float(1),
+		DefaultValue: option.Float(5),
+	})
+	root := newTestRootContainer(slider)
+
+	x, y := slider.AbsPosition()
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 1)
+	is.Equal(slider.GetValue(), 6.0)
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, -1)
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, -1)
+	is.Equal(slider.GetValue(), 4.0)
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+100, 0, 1)
+	is.Equal(slider.GetValue(), 4.0) // not over the slider
+
+	slider.SetDisabled(true)
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 1)
+	is.Equal(slider.GetValue(), 4.0)
+}
+
+func TestSlider_HandleAnimation(t *testing.T) {
+	is := is.New(t)
+
+	eventManager := newTestEventManager()
+	clock := eventManager.Scheduler().Clock().(*timer.FakeClock)
+	animator := tween.NewAnimator(eventManager.Scheduler(), eventManager)
+
+	slider := NewSlider(&SliderOptions{
+		Min:                     option.Float(0),
+		Max:                     option.Float(10),
+		Step:                    option.Float(1),
+		Width:                   option.Int(100),
+		HandleAnimationDuration: time.Second,
+		HandleEasing:            tween.Linear,
+	})
+	root := newTestRootContainer(slider)
+	root.SetEventManager(eventManager)
+	root.SetAnimator(animator)
+
+	wait := func(d time.Duration) {
+		clock.Advance(d)
+		eventManager.Scheduler().Tick()
+		eventManager.HandleFired()
+	}
+
+	start := slider.handle.PosX()
+	slider.Set(5)
+	end := slider.calcHandlePosition()
+	is.Equal(slider.GetValue(), 5.0) // the value is set immediately
+	is.Equal(slider.handle.PosX(), start)
+	is.True(animator.Playing(slider.handleTween)) // the handle is animated by the root container's animator
+
+	wait(500 * time.Millisecond)
+	is.Equal(slider.handle.PosX(), (start+end)/2)
+
+	wait(500 * time.Millisecond)
+	is.Equal(slider.handle.PosX(), end)
+
+	slider.sliding = true
+	slider.Set(2)
+	is.Equal(slider.handle.PosX(), slider.calcHandlePosition()) // the handle follows the cursor without the animation
+}
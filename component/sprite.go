@@ -11,6 +11,7 @@ type Sprite struct {
 
 type SpriteOptions struct {
 	Padding *Padding
+	Tooltip *Tooltip
 }
 
 func NewSprite(image *ebiten.Image, options *SpriteOptions) *Sprite {
@@ -32,6 +33,7 @@ func (s *Sprite) setUpComponent(options *SpriteOptions) {
 	if options != nil {
 		componentOptions = ComponentOptions{
 			Padding: options.Padding,
+			Tooltip: options.Tooltip,
 		}
 	}
 
@@ -0,0 +1,581 @@
+package component
+
+import (
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+
+	colorutils "github.com/fglo/chopstiqs/color"
+	fontutils "github.com/fglo/chopstiqs/font"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+
+	// TODO: update to github.com/hajimehoshi/ebiten/v2/text/v2
+	"github.com/hajimehoshi/ebiten/v2/text" // nolint
+	"golang.org/x/image/font"
+)
+
+// TextArea is a multi-line text input, like a chat message box or a notes field.
+// It has the editing actions of the text input, but Enter inserts a line break and Ctrl+Enter (Cmd+Enter on macOS) submits the text.
+// Lines wider than the text area are wrapped at the spaces, or between the characters of words that don't fit in a line.
+type TextArea struct {
+	TextInput
+
+	// lines are the lines of the text after wrapping. They're laid out again when the value or the width changes.
+	lines      []textAreaLine
+	linesValue string
+	linesWidth int
+
+	lineHeight int
+	// scrollLine is the index of the first visible line
+	scrollLine int
+
+	// goalX is the x coordinate the cursor keeps to when it moves up and down through shorter lines.
+	// It's used as long as the cursor stays at goalPosition, where the last vertical move left it.
+	goalX        int
+	goalPosition textInputCursorPosition
+
+	maxLines int
+
+	drawer TextAreaDrawer
+}
+
+// textAreaLine is a line of the text area's text, from start to end, without the line break.
+type textAreaLine struct {
+	start textInputCursorPosition
+	end   textInputCursorPosition
+	// wrapped is set when the line doesn't end with a line break, so the next line starts at the end of this one
+	wrapped bool
+}
+
+type TextAreaOptions struct {
+	Width  option.OptInt
+	Height option.OptInt
+
+	Drawer TextAreaDrawer
+
+	Color         color.Color
+	ColorDisabled color.Color
+	ColorHovered  color.Color
+	Font          font.Face
+
+	Padding *Padding
+	Tooltip *Tooltip
+
+	OnSubmitFunc        TextInputOnSubmitFunc
+	InputValidationFunc TextInputValidationFunc
+
+	SubmitOnUnfocus bool
+
+	// MaxLines is the maximum number of lines separated by line breaks, not counting the wrapped lines. If not set, the number of lines isn't limited.
+	MaxLines option.OptInt
+
+	CursorOptions *TextInputCursorOptions
+}
+
+func NewTextArea(options *TextAreaOptions) *TextArea {
+	ta := &TextArea{
+		goalPosition: -1,
+
+		drawer: DefaultTextAreaDrawer{
+			Color:         color.RGBA{230, 230, 230, 255},
+			ColorDisabled: color.RGBA{150, 150, 150, 255},
+			ColorHovered:  color.RGBA{250, 250, 250, 255},
+		},
+	}
+
+	textInputOptions := &TextInputOptions{
+		Width:  option.Int(120),
+		Height: option.Int(48),
+	}
+
+	if options != nil {
+		if options.Width.IsSet() {
+			textInputOptions.Width = options.Width
+		}
+
+		if options.Height.IsSet() {
+			textInputOptions.Height = options.Height
+		}
+
+		textInputOptions.Color = options.Color
+		textInputOptions.ColorDisabled = options.ColorDisabled
+		textInputOptions.ColorHovered = options.ColorHovered
+		textInputOptions.Font = options.Font
+		textInputOptions.Padding = options.Padding
+		textInputOptions.Tooltip = options.Tooltip
+		textInputOptions.OnSubmitFunc = options.OnSubmitFunc
+		textInputOptions.InputValidationFunc = options.InputValidationFunc
+		textInputOptions.SubmitOnUnfocus = options.SubmitOnUnfocus
+		textInputOptions.CursorOptions = options.CursorOptions
+
+		if options.MaxLines.IsSet() {
+			ta.maxLines = options.MaxLines.Val()
+		}
+
+		if options.Drawer != nil {
+			ta.drawer = options.Drawer
+		}
+	}
+
+	ta.TextInput.init(textInputOptions)
+
+	ta.lineHeight = ta.metrics.Height + 3
+	ta.cursor.SetHeight(ta.lineHeight)
+
+	validate := ta.inputValidationFunc
+	ta.inputValidationFunc = func(value string) (bool, string) {
+		valid, value := validate(value)
+		if valid && ta.maxLines > 0 && strings.Count(value, "\n") >= ta.maxLines {
+			return false, value
+		}
+
+		return valid, value
+	}
+
+	ta.positionAt = ta.findClosestPosition
+	ta.scroll = ta.scrollVertically
+	ta.dragScroll = ta.dragScrollVertically
+
+	ta.actionKeys = append(ta.actionKeys, ebiten.KeyUp, ebiten.KeyDown, ebiten.KeyPageUp, ebiten.KeyPageDown)
+
+	ta.actionKeyHandlers[ebiten.KeyControl] = ta.handleKeyCtrl
+	ta.actionKeyHandlers[ebiten.KeyMeta] = ta.handleKeyMeta
+	ta.actionKeyHandlers[ebiten.KeyEnter] = ta.handleKeyEnter
+	ta.actionKeyHandlers[ebiten.KeyUp] = ta.handleKeyUp
+	ta.actionKeyHandlers[ebiten.KeyDown] = ta.handleKeyDown
+	ta.actionKeyHandlers[ebiten.KeyPageUp] = ta.handleKeyPageUp
+	ta.actionKeyHandlers[ebiten.KeyPageDown] = ta.handleKeyPageDown
+
+	ta.actionHandlers[textInputHome] = ta.LineHome
+	ta.actionHandlers[textInputEnd] = ta.LineEnd
+	ta.actionHandlers[textInputCursorUp] = ta.CursorUp
+	ta.actionHandlers[textInputCursorDown] = ta.CursorDown
+	ta.actionHandlers[textInputPageUp] = ta.PageUp
+	ta.actionHandlers[textInputPageDown] = ta.PageDown
+	ta.actionHandlers[textInputTextStart] = ta.TextInput.Home
+	ta.actionHandlers[textInputTextEnd] = ta.TextInput.End
+	ta.actionHandlers[textInputNewLine] = ta.NewLine
+
+	ta.updateLines()
+
+	return ta
+}
+
+// SetHeight sets the component's height.
+func (ta *TextArea) SetHeight(height int) {
+	ta.TextInput.SetHeight(height)
+	ta.cursor.SetHeight(ta.lineHeight)
+}
+
+// SetDimensions sets the component's dimensions (width and height).
+func (ta *TextArea) SetDimensions(width, height int) {
+	ta.TextInput.SetDimensions(width, height)
+	ta.cursor.SetHeight(ta.lineHeight)
+}
+
+// LineCount returns the number of lines of the text, including the wrapped lines.
+func (ta *TextArea) LineCount() int {
+	ta.updateLines()
+
+	return len(ta.lines)
+}
+
+// CursorLine returns the index of the line the cursor is in, counting the wrapped lines.
+func (ta *TextArea) CursorLine() int {
+	ta.updateLines()
+
+	return ta.lineAt(ta.cursorPosition)
+}
+
+// ScrollLine returns the index of the first visible line.
+func (ta *TextArea) ScrollLine() int {
+	return ta.scrollLine
+}
+
+// NewLine inserts a line break at the cursor, replacing the selected text.
+func (ta *TextArea) NewLine() {
+	ta.Insert([]rune{'\n'})
+}
+
+// CursorUp moves the cursor to the previous line, or to the beginning of the text from the first line.
+func (ta *TextArea) CursorUp() {
+	ta.moveVertically(-1)
+}
+
+// CursorDown moves the cursor to the next line, or to the end of the text from the last line.
+func (ta *TextArea) CursorDown() {
+	ta.moveVertically(1)
+}
+
+// PageUp moves the cursor up by the number of the visible lines, at most to the first line.
+func (ta *TextArea) PageUp() {
+	ta.moveVertically(-ta.visibleLineCount())
+}
+
+// PageDown moves the cursor down by the number of the visible lines, at most to the last line.
+func (ta *TextArea) PageDown() {
+	ta.moveVertically(ta.visibleLineCount())
+}
+
+// LineHome moves the cursor to the beginning of its line.
+func (ta *TextArea) LineHome() {
+	ta.updateLines()
+	ta.moveCursor(ta.lines[ta.lineAt(ta.cursorPosition)].start)
+}
+
+// LineEnd moves the cursor to the end of its line. In a wrapped line, it stops before the last character, as the position after it starts the next line.
+func (ta *TextArea) LineEnd() {
+	ta.updateLines()
+	ta.moveCursor(ta.lastPositionInLine(ta.lineAt(ta.cursorPosition)))
+}
+
+func (ta *TextArea) moveVertically(lines int) {
+	ta.updateLines()
+
+	line := ta.lineAt(ta.cursorPosition)
+
+	goalX := ta.goalX
+	if ta.cursorPosition != ta.goalPosition {
+		goalX = ta.lineX(ta.cursorPosition, line)
+	}
+
+	target := min(max(line+lines, 0), len(ta.lines)-1)
+	switch {
+	case target == line && lines < 0:
+		ta.TextInput.Home()
+	case target == line && lines > 0:
+		ta.TextInput.End()
+	default:
+		ta.moveCursor(ta.positionInLine(target, goalX))
+	}
+
+	ta.goalX = goalX
+	ta.goalPosition = ta.cursorPosition
+}
+
+// updateLines wraps the text into lines, if the value or the width changed since it was last wrapped.
+func (ta *TextArea) updateLines() {
+	width := ta.width - 2*ta.textPosX
+	if ta.lines != nil && ta.linesValue == ta.value && ta.linesWidth == width {
+		return
+	}
+
+	ta.linesValue = ta.value
+	ta.linesWidth = width
+	ta.lines = ta.lines[:0]
+
+	var start textInputCursorPosition
+	var lastSpace textInputCursorPosition = -1
+
+	for i := textInputCursorPosition(0); i <= ta.length(); i++ {
+		if i == ta.length() || isLineBreak(ta.grapheme(i)) {
+			ta.lines = append(ta.lines, textAreaLine{start: start, end: i})
+			start = i + 1
+			lastSpace = -1
+			continue
+		}
+
+		// spaces may stick out of the line, so the next line doesn't start with one
+		if ta.grapheme(i) == " " {
+			lastSpace = i
+			continue
+		}
+
+		if i > start && ta.possibleCursorPosXs[i+1]-ta.possibleCursorPosXs[start] > width {
+			end := i
+			if lastSpace >= start {
+				end = lastSpace + 1
+			}
+
+			ta.lines = append(ta.lines, textAreaLine{start: start, end: end, wrapped: true})
+			start = end
+			lastSpace = -1
+
+			// the character is checked again at the start of the next line
+			i--
+		}
+	}
+}
+
+// lineAt returns the index of the line with the cursor position.
+func (ta *TextArea) lineAt(position textInputCursorPosition) int {
+	return sort.Search(len(ta.lines), func(i int) bool {
+		return ta.lines[i].start > position
+	}) - 1
+}
+
+// lastPositionInLine returns the last cursor position in the line.
+func (ta *TextArea) lastPositionInLine(line int) textInputCursorPosition {
+	l := ta.lines[line]
+	if l.wrapped && l.end > l.start {
+		return l.end - 1
+	}
+
+	return l.end
+}
+
+// lineX returns the x coordinate of the cursor position relative to the beginning of the line.
+func (ta *TextArea) lineX(position textInputCursorPosition, line int) int {
+	return ta.possibleCursorPosXs[position] - ta.possibleCursorPosXs[ta.lines[line].start]
+}
+
+// positionInLine returns the cursor position in the line closest to the x coordinate.
+func (ta *TextArea) positionInLine(line int, x int) textInputCursorPosition {
+	closest := ta.lines[line].start
+
+	for position := closest + 1; position <= ta.lastPositionInLine(line); position++ {
+		if math.Abs(float64(ta.lineX(position, line)-x)) <= math.Abs(float64(ta.lineX(closest, line)-x)) {
+			closest = position
+		}
+	}
+
+	return closest
+}
+
+// findClosestPosition returns the cursor position closest to the mouse cursor's coordinates.
+func (ta *TextArea) findClosestPosition(cursorPosX, cursorPosY int) textInputCursorPosition {
+	ta.updateLines()
+
+	y := cursorPosY - int(ta.absPosY) - ta.padding.Top - 2
+	line := ta.scrollLine + int(math.Floor(float64(y)/float64(ta.lineHeight)))
+
+	switch {
+	case line < 0:
+		line = 0
+	case line >= len(ta.lines):
+		line = len(ta.lines) - 1
+	}
+
+	return ta.positionInLine(line, cursorPosX-int(ta.absPosX)-ta.textPosX-ta.padding.Left+1)
+}
+
+// visibleLineCount returns the number of lines that fit in the text area.
+func (ta *TextArea) visibleLineCount() int {
+	if count := (ta.height - 4) / ta.lineHeight; count > 1 {
+		return count
+	}
+
+	return 1
+}
+
+// scrollVertically scrolls the text by a line per step of the mouse wheel. It returns whether the scroll line changed.
+func (ta *TextArea) scrollVertically(wheelX, wheelY float64) bool {
+	ta.updateLines()
+
+	scrollLine := ta.scrollLine
+	ta.scrollLine = ta.boundScrollLine(ta.scrollLine - int(math.Round(wheelY)))
+	ta.mouseScrolled = true
+
+	return ta.scrollLine != scrollLine
+}
+
+// dragScrollVertically scrolls the text a line towards the mouse cursor dragged above or below the text area.
+func (ta *TextArea) dragScrollVertically(cursorPosX, cursorPosY int) {
+	top := int(ta.absPosY) + ta.padding.Top
+
+	switch {
+	case cursorPosY < top:
+		ta.scrollVertically(0, 1)
+	case cursorPosY >= top+ta.height:
+		ta.scrollVertically(0, -1)
+	}
+}
+
+// calcScrollLine scrolls the text, so the line with the cursor is visible, unless it was scrolled with the mouse.
+func (ta *TextArea) calcScrollLine() int {
+	ta.scrollLine = ta.boundScrollLine(ta.scrollLine)
+
+	if ta.mouseScrolled {
+		return ta.scrollLine
+	}
+
+	line := ta.lineAt(ta.cursorPosition)
+
+	switch {
+	case line < ta.scrollLine:
+		ta.scrollLine = line
+	case line >= ta.scrollLine+ta.visibleLineCount():
+		ta.scrollLine = line - ta.visibleLineCount() + 1
+	}
+
+	return ta.scrollLine
+}
+
+// boundScrollLine limits the scrolled line to the range in which the text fills the text area.
+func (ta *TextArea) boundScrollLine(line int) int {
+	scrollLineUpperBound := len(ta.lines) - ta.visibleLineCount()
+	if scrollLineUpperBound < 0 {
+		scrollLineUpperBound = 0
+	}
+
+	switch {
+	case line < 0:
+		return 0
+	case line > scrollLineUpperBound:
+		return scrollLineUpperBound
+	default:
+		return line
+	}
+}
+
+// selectionInLine returns the x coordinates between which the selected text of the line is highlighted, or false if nothing is selected in it.
+// A selection continuing past a line break sticks out of the line a bit, so the selected empty lines are highlighted as well.
+func (ta *TextArea) selectionInLine(line int) (int, int, bool) {
+	l := ta.lines[line]
+	if !ta.HasSelectedText() || ta.selectionEnd < l.start || ta.selectionStart > l.end {
+		return 0, 0, false
+	}
+
+	from := ta.lineX(max(ta.selectionStart, l.start), line)
+	to := ta.lineX(min(ta.selectionEnd, l.end), line)
+
+	if ta.selectionEnd > l.end && !l.wrapped {
+		to += fontutils.MeasureString(" ", ta.font)
+	}
+
+	return from, to, from < to
+}
+
+func (ta *TextArea) handleKeyUp() textInputAction {
+	ta.checkForShift()
+
+	return textInputCursorUp
+}
+
+func (ta *TextArea) handleKeyDown() textInputAction {
+	ta.checkForShift()
+
+	return textInputCursorDown
+}
+
+func (ta *TextArea) handleKeyPageUp() textInputAction {
+	ta.checkForShift()
+
+	return textInputPageUp
+}
+
+func (ta *TextArea) handleKeyPageDown() textInputAction {
+	ta.checkForShift()
+
+	return textInputPageDown
+}
+
+func (ta *TextArea) handleKeyEnter() textInputAction {
+	for key, pressed := range ta.modifierKeysPressed {
+		if pressed && key != ebiten.KeyShift {
+			return textInputIdle
+		}
+	}
+
+	return textInputNewLine
+}
+
+func (ta *TextArea) handleKeyCtrl() textInputAction {
+	if ta.inputSource.OS().IsMacOS() {
+		return textInputIdle
+	}
+
+	switch {
+	case ta.inputSource.KeyPressed(ebiten.KeyEnter):
+		return textInputSubmit
+	case ta.inputSource.KeyPressed(ebiten.KeyHome):
+		ta.checkForShift()
+		return textInputTextStart
+	case ta.inputSource.KeyPressed(ebiten.KeyEnd):
+		ta.checkForShift()
+		return textInputTextEnd
+	default:
+		return ta.TextInput.handleKeyCtrl()
+	}
+}
+
+func (ta *TextArea) handleKeyMeta() textInputAction {
+	if !ta.inputSource.OS().IsMacOS() {
+		return textInputIdle
+	}
+
+	switch {
+	case ta.inputSource.KeyPressed(ebiten.KeyEnter):
+		return textInputSubmit
+	case ta.inputSource.KeyPressed(ebiten.KeyUp):
+		ta.checkForShift()
+		return textInputTextStart
+	case ta.inputSource.KeyPressed(ebiten.KeyDown):
+		ta.checkForShift()
+		return textInputTextEnd
+	default:
+		return ta.TextInput.handleKeyMeta()
+	}
+}
+
+// cursorPos returns the position of the cursor in the text area's image.
+func (ta *TextArea) cursorPos() (float64, float64) {
+	line := ta.lineAt(ta.cursorPosition)
+
+	return float64(ta.lineX(ta.cursorPosition, line) + ta.textPosX + ta.padding.Left - 1), float64((line-ta.scrollLine)*ta.lineHeight + 2 + ta.padding.Top)
+}
+
+func (ta *TextArea) drawText(clr color.RGBA) {
+	lastVisibleLine := min(len(ta.lines), ta.scrollLine+ta.visibleLineCount()) - 1
+
+	for i := ta.scrollLine; i <= lastVisibleLine; i++ {
+		line := ta.lines[i]
+		x := ta.textPosX + ta.padding.Left
+		y := ta.textPosY + ta.padding.Top + (i-ta.scrollLine)*ta.lineHeight
+
+		// the line is drawn in segments, with the selected one in the inverted color
+		for from := line.start; from < line.end; {
+			to := line.end
+			segmentColor := clr
+
+			switch {
+			case ta.HasSelectedText() && from >= ta.selectionStart && from < ta.selectionEnd:
+				to = min(to, ta.selectionEnd)
+				segmentColor = colorutils.Invert(clr)
+			case ta.HasSelectedText() && from < ta.selectionStart:
+				to = min(to, ta.selectionStart)
+			}
+
+			text.Draw(ta.image, ta.value[ta.offset(from):ta.offset(to)], ta.font, x+ta.lineX(from, i), y, segmentColor)
+			from = to
+		}
+	}
+}
+
+func (ta *TextArea) Draw() *ebiten.Image {
+	if ta.hidden {
+		return ta.image
+	}
+
+	ta.updateLines()
+	ta.updateSelectionBounds()
+
+	if ta.focused && !ta.disabled {
+		ta.scrollLine = ta.calcScrollLine()
+	} else if !ta.mouseScrolled {
+		ta.scrollLine = 0
+	}
+
+	ta.drawer.Draw(ta)
+
+	if ta.focused && !ta.disabled {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(ta.cursorPos())
+		ta.image.DrawImage(ta.cursor.Draw(), op)
+	}
+
+	switch {
+	case ta.disabled:
+		ta.drawText(ta.colorDisabled)
+	case ta.hovering:
+		ta.drawText(ta.colorHovered)
+	default:
+		ta.drawText(ta.color)
+	}
+
+	ta.component.Draw()
+
+	return ta.image
+}
@@ -0,0 +1,91 @@
+package component
+
+import (
+	"image/color"
+
+	colorutils "github.com/fglo/chopstiqs/color"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+type TextAreaDrawer interface {
+	Draw(textArea *TextArea) *ebiten.Image
+}
+
+// DefaultTextAreaDrawer draws the text area as a box like the text input's, with the selected text highlighted line by line.
+type DefaultTextAreaDrawer struct {
+	Color           color.RGBA
+	ColorDisabled   color.RGBA
+	ColorHovered    color.RGBA
+	BackgroundColor color.Color
+}
+
+func (d DefaultTextAreaDrawer) Draw(ta *TextArea) *ebiten.Image {
+	arr := make([]byte, ta.component.pixelRows*ta.component.pixelCols)
+	cornerColor := ta.container.GetBackgroundColor()
+
+	backgroundColor := cornerColor
+	if d.BackgroundColor != nil {
+		backgroundColor = colorutils.ToRGBA(d.BackgroundColor)
+	}
+
+	borderColor := d.Color
+	switch {
+	case ta.disabled:
+		borderColor = d.ColorDisabled
+	case ta.hovering:
+		borderColor = d.ColorHovered
+	}
+
+	for y := 0; y < ta.height; y++ {
+		rowNumber := ta.component.pixelCols * (y + ta.padding.Top)
+		selectedFrom, selectedTo, selected := d.selectionInRow(ta, y)
+
+		for x := 0; x < ta.width; x++ {
+			colId := (x + ta.padding.Left) * 4
+
+			c := backgroundColor
+			switch {
+			case d.isCorner(ta, x, y):
+				c = cornerColor
+			case d.isBorder(ta, x, y):
+				c = borderColor
+			case selected && x >= selectedFrom && x < selectedTo:
+				c = colorutils.Invert(backgroundColor)
+			}
+
+			arr[colId+rowNumber] = c.R
+			arr[colId+1+rowNumber] = c.G
+			arr[colId+2+rowNumber] = c.B
+			arr[colId+3+rowNumber] = c.A
+		}
+	}
+
+	ta.image.WritePixels(arr)
+
+	return ta.image
+}
+
+// selectionInRow returns the x coordinates between which the row of pixels is highlighted, or false if the row isn't in a line with selected text.
+func (d DefaultTextAreaDrawer) selectionInRow(ta *TextArea, y int) (int, int, bool) {
+	if y < 2 {
+		return 0, 0, false
+	}
+
+	visibleLine := (y - 2) / ta.lineHeight
+	line := ta.scrollLine + visibleLine
+	if visibleLine >= ta.visibleLineCount() || line >= len(ta.lines) {
+		return 0, 0, false
+	}
+
+	from, to, selected := ta.selectionInLine(line)
+
+	return from + ta.textPosX, to + ta.textPosX, selected
+}
+
+func (d DefaultTextAreaDrawer) isCorner(ta *TextArea, x, y int) bool {
+	return (x == 0 || x == ta.width-1) && (y == 0 || y == ta.height-1)
+}
+
+func (d DefaultTextAreaDrawer) isBorder(ta *TextArea, x, y int) bool {
+	return x == 0 || x == ta.width-1 || y == 0 || y == ta.height-1
+}
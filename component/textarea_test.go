@@ -0,0 +1,413 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func newTestTextArea(in input.InputSource, options *TextAreaOptions) *TextArea {
+	ta := NewTextArea(options)
+	ta.SetEventManager(newTestEventManager())
+	ta.inputSource = in
+
+	return ta
+}
+
+func TestTextArea_Lines(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []textAreaLine
+	}{
+		{
+			name:  "Empty",
+			value: "",
+			want:  []textAreaLine{{start: 0, end: 0}},
+		},
+		{
+			name:  "Line breaks",
+			value: "a\n\nb",
+			want:  []textAreaLine{{start: 0, end: 1}, {start: 2, end: 2}, {start: 3, end: 4}},
+		},
+		{
+			name:  "Wrapped at a space",
+			value: "aaaa bbbb",
+			want:  []textAreaLine{{start: 0, end: 5, wrapped: true}, {start: 5, end: 9}},
+		},
+		{
+			name:  "Long word wrapped between characters",
+			value: "aaaaaaaaaa",
+			want:  []textAreaLine{{start: 0, end: 5, wrapped: true}, {start: 5, end: 10}},
+		},
+		{
+			name:  "Multibyte characters",
+			value: "żółw żółw",
+			want:  []textAreaLine{{start: 0, end: 5, wrapped: true}, {start: 5, end: 9}},
+		},
+		{
+			name:  "Spaces stick out of the line",
+			value: "aaaaa     b",
+			want:  []textAreaLine{{start: 0, end: 10, wrapped: true}, {start: 10, end: 11}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			ta := NewTextArea(&TextAreaOptions{Width: option.Int(40)})
+			ta.SetValue(tt.value)
+
+			is.Equal(ta.LineCount(), len(tt.want))
+			is.Equal(ta.lines, tt.want)
+		})
+	}
+}
+
+func TestTextArea_Rewrapped(t *testing.T) {
+	is := is.New(t)
+
+	ta := NewTextArea(&TextAreaOptions{Width: option.Int(40)})
+	ta.SetValue("aaaa bbbb")
+	is.Equal(ta.LineCount(), 2)
+
+	ta.SetWidth(80)
+	is.Equal(ta.LineCount(), 1) // the lines are wrapped again when the width changes
+}
+
+func TestTextArea_PressedEnter(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+	in.SetOS(input.Windows)
+
+	submitted := ""
+
+	ta := newTestTextArea(in, nil)
+	ta.SetValue("ab")
+	ta.AddSubmittedHandler(func(args *TextInputSubmittedEventArgs) {
+		submitted = args.Text
+	})
+
+	ta.focused = true
+	ta.cursorPosition = 1
+
+	keyPress(t, in, ebiten.KeyEnter)
+	handleState(t, &ta.TextInput)
+	handleState(t, &ta.TextInput)
+	keyRelease(t, in, ebiten.KeyEnter)
+	handleState(t, &ta.TextInput)
+
+	is.Equal(ta.Value(), "a\nb")
+	is.Equal(ta.CursorLine(), 1)
+	is.Equal(submitted, "") // Enter inserts a line break
+
+	wait(t, &ta.TextInput, textInputActionRepeatDelay)
+
+	keyPress(t, in, ebiten.KeyControl)
+	keyPress(t, in, ebiten.KeyEnter)
+	handleState(t, &ta.TextInput)
+	handleState(t, &ta.TextInput)
+
+	is.Equal(submitted, "a\nb") // Ctrl+Enter submits
+}
+
+func TestTextArea_keyCombinations(t *testing.T) {
+	in := newTestInputSource(t)
+
+	tests := []struct {
+		name        string
+		pressedKeys []ebiten.Key
+		before      func(ta *TextArea)
+		want        textInputAction
+	}{
+		{
+			name:        "Enter",
+			pressedKeys: []ebiten.Key{ebiten.KeyEnter},
+			want:        textInputNewLine,
+		},
+		{
+			name:        "Enter + Shift",
+			pressedKeys: []ebiten.Key{ebiten.KeyEnter, ebiten.KeyShift},
+			want:        textInputNewLine,
+		},
+		{
+			name:        "Enter + Alt",
+			pressedKeys: []ebiten.Key{ebiten.KeyEnter, ebiten.KeyAlt},
+			want:        textInputIdle,
+		},
+		{
+			name:        "Enter + CTRL (Windows)",
+			pressedKeys: []ebiten.Key{ebiten.KeyEnter, ebiten.KeyControl},
+			before:      func(ta *TextArea) { in.SetOS(input.Windows) },
+			want:        textInputSubmit,
+		},
+		{
+			name:        "Enter + CMD (MacOS)",
+			pressedKeys: []ebiten.Key{ebiten.KeyEnter, ebiten.KeyMeta},
+			before:      func(ta *TextArea) { in.SetOS(input.MacOS) },
+			want:        textInputSubmit,
+		},
+		{
+			name:        "Up",
+			pressedKeys: []ebiten.Key{ebiten.KeyUp},
+			want:        textInputCursorUp,
+		},
+		{
+			name:        "Down",
+			pressedKeys: []ebiten.Key{ebiten.KeyDown},
+			want:        textInputCursorDown,
+		},
+		{
+			name:        "Page Up",
+			pressedKeys: []ebiten.Key{ebiten.KeyPageUp},
+			want:        textInputPageUp,
+		},
+		{
+			name:        "Page Down",
+			pressedKeys: []ebiten.Key{ebiten.KeyPageDown},
+			want:        textInputPageDown,
+		},
+		{
+			name:        "Home + CTRL (Windows)",
+			pressedKeys: []ebiten.Key{ebiten.KeyHome, ebiten.KeyControl},
+			before:      func(ta *TextArea) { in.SetOS(input.Windows) },
+			want:        textInputTextStart,
+		},
+		{
+			name:        "End + CTRL (Windows)",
+			pressedKeys: []ebiten.Key{ebiten.KeyEnd, ebiten.KeyControl},
+			before:      func(ta *TextArea) { in.SetOS(input.Windows) },
+			want:        textInputTextEnd,
+		},
+		{
+			name:        "Up + CMD (MacOS)",
+			pressedKeys: []ebiten.Key{ebiten.KeyUp, ebiten.KeyMeta},
+			before:      func(ta *TextArea) { in.SetOS(input.MacOS) },
+			want:        textInputTextStart,
+		},
+		{
+			name:        "Down + CMD (MacOS)",
+			pressedKeys: []ebiten.Key{ebiten.KeyDown, ebiten.KeyMeta},
+			before:      func(ta *TextArea) { in.SetOS(input.MacOS) },
+			want:        textInputTextEnd,
+		},
+		{
+			name:        "CTRL + A (Windows)",
+			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyA},
+			before:      func(ta *TextArea) { in.SetOS(input.Windows) },
+			want:        textInputSelectAll,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in = newTestInputSource(t)
+
+			ta := newTestTextArea(in, nil)
+
+			if tt.before != nil {
+				tt.before(ta)
+			}
+
+			for _, key := range tt.pressedKeys {
+				in.PressKey(key)
+			}
+
+			in.Update()
+
+			if pressed, key := ta.actionKeyPressed(); pressed {
+				got := ta.handleActionKey(key)
+
+				if got != tt.want {
+					t.Errorf("got %s, want %s", got, tt.want)
+				}
+			} else {
+				t.Fatal("No action keys were pressed.")
+			}
+		})
+	}
+}
+
+func TestTextArea_CursorUpDown(t *testing.T) {
+	is := is.New(t)
+
+	ta := NewTextArea(nil)
+	ta.SetValue("aaaa\naa\naaaa")
+	ta.cursorPosition = 4
+
+	ta.CursorDown()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(7)) // the end of the shorter line
+
+	ta.CursorDown()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(12)) // back in the column the cursor started in
+
+	ta.CursorDown()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(12)) // the end of the text
+
+	ta.CursorUp()
+	ta.CursorUp()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(4))
+
+	ta.CursorUp()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(0)) // the beginning of the text
+}
+
+func TestTextArea_PageUpDown(t *testing.T) {
+	is := is.New(t)
+
+	ta := NewTextArea(nil)
+	ta.SetValue("1\n2\n3\n4\n5\n6\n7\n8\n9")
+	is.Equal(ta.visibleLineCount(), 4)
+
+	ta.PageDown()
+	is.Equal(ta.CursorLine(), 4)
+
+	ta.PageDown()
+	ta.PageDown()
+	is.Equal(ta.CursorLine(), 8) // stops at the last line
+
+	ta.PageUp()
+	is.Equal(ta.CursorLine(), 4)
+}
+
+func TestTextArea_LineHomeEnd(t *testing.T) {
+	is := is.New(t)
+
+	ta := NewTextArea(&TextAreaOptions{Width: option.Int(40)})
+	ta.SetValue("ab\naaaa bbbb")
+	ta.cursorPosition = 1
+
+	ta.LineEnd()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(2))
+
+	ta.cursorPosition = 4
+	ta.LineEnd()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(7)) // before the space the wrapped line ends with
+
+	ta.cursorPosition = 10
+	ta.LineHome()
+	is.Equal(ta.cursorPosition, textInputCursorPosition(8)) // the beginning of the wrapped line
+}
+
+func TestTextArea_MaxLines(t *testing.T) {
+	is := is.New(t)
+
+	ta := NewTextArea(&TextAreaOptions{MaxLines: option.Int(2)})
+	ta.SetValue("a\nb")
+
+	ta.End()
+	ta.NewLine()
+	is.Equal(ta.Value(), "a\nb")
+
+	ta.SetValue("a\nb\nc")
+	is.Equal(ta.Value(), "a\nb")
+
+	ta.Insert([]rune("c"))
+	is.Equal(ta.Value(), "a\nbc")
+}
+
+func TestTextArea_Scroll(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ta := NewTextArea(nil)
+	root := newTestRootContainer(ta)
+	ta.SetValue("1\n2\n3\n4\n5\n6\n7\n8\n9")
+
+	ta.focused = true
+	ta.End()
+	ta.Draw()
+	is.Equal(ta.ScrollLine(), 5) // follows the cursor to the last line
+
+	x, y := ta.AbsPosition()
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 2)
+	is.Equal(ta.ScrollLine(), 3)
+
+	ta.Draw()
+	is.Equal(ta.ScrollLine(), 3) // doesn't follow the cursor after scrolling with the wheel
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 10)
+	is.Equal(ta.ScrollLine(), 0) // bounded at the first line
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, -10)
+	is.Equal(ta.ScrollLine(), 5) // bounded at the last page
+
+	ta.TextInput.Home()
+	ta.Draw()
+	is.Equal(ta.ScrollLine(), 0) // follows the cursor again after it moved
+}
+
+func TestTextArea_MouseClick(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ta := NewTextArea(nil)
+	root := newTestRootContainer(ta)
+	ta.SetValue("aaaa\naaaa\naaaa")
+
+	x, y := ta.AbsPosition()
+
+	// the beginning of the third character of the second line
+	in.MoveCursor(int(x)+ta.textPosX+13, int(y)+2+ta.lineHeight+5)
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	fireRootEvents(t, root, in)
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	fireRootEvents(t, root, in)
+
+	is.Equal(ta.CursorLine(), 1)
+	is.Equal(ta.cursorPosition, textInputCursorPosition(7))
+}
+
+func TestTextArea_Undo(t *testing.T) {
+	is := is.New(t)
+
+	ta := NewTextArea(nil)
+	ta.Insert([]rune("ab"))
+	ta.CursorLeft()
+	ta.NewLine()
+	is.Equal(ta.LineCount(), 2)
+
+	ta.Undo()
+	is.Equal(ta.Value(), "ab")
+	is.Equal(ta.LineCount(), 1)
+	is.Equal(ta.cursorPosition, textInputCursorPosition(1))
+}
+
+func TestTextArea_MouseDragAutoScroll(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ta := NewTextArea(nil)
+	root := newTestMouseRoot(ta)
+	ta.SetValue("1\n2\n3\n4\n5\n6\n7\n8")
+
+	x, y := ta.AbsPosition()
+	clock := root.eventManager.Scheduler().Clock().(*timer.FakeClock)
+
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, int(x)+1, int(y)+5)
+
+	// the text scrolls a line per interval while the mouse is dragged below the text area
+	mouseFrame(t, root, in, int(x)+1, int(y)+ta.height+20)
+	is.Equal(ta.ScrollLine(), 1)
+
+	mouseFrame(t, root, in, int(x)+1, int(y)+ta.height+20)
+	is.Equal(ta.ScrollLine(), 1)
+
+	clock.Advance(textInputDragScrollInterval)
+	mouseFrame(t, root, in, int(x)+1, int(y)+ta.height+20)
+	is.Equal(ta.ScrollLine(), 2)
+
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, int(x)+1, int(y)+ta.height+20)
+	ta.Draw()
+	is.Equal(ta.ScrollLine(), 2)
+	is.Equal(ta.selectingFrom, textInputCursorPosition(0))
+	is.True(ta.CursorLine() > ta.ScrollLine())
+}
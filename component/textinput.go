@@ -3,7 +3,7 @@ package component
 import (
 	"image/color"
 	"math"
-	"sync/atomic"
+	"sort"
 	"time"
 
 	"github.com/fglo/chopstiqs/clipboard"
@@ -19,7 +19,11 @@ import (
 	"golang.org/x/image/font"
 )
 
-// textInputCursorPosition is a type indicating that value is one of the possible cursor positions, not coordinate in the X axis
+// textInputWheelScrollSpeed is the number of pixels the text is scrolled by per mouse wheel step.
+const textInputWheelScrollSpeed = 8
+
+// textInputCursorPosition is a type indicating that value is one of the possible cursor positions, not coordinate in the X axis.
+// The positions are between the grapheme clusters of the value, not its bytes.
 type textInputCursorPosition int
 
 // textInputAction is a type refering to a action triggered by a action key with (or without) modifier keys
@@ -49,6 +53,13 @@ const (
 	textInputPaste
 	textInputCut
 	textInputUnfocus
+	textInputCursorUp
+	textInputCursorDown
+	textInputPageUp
+	textInputPageDown
+	textInputTextStart
+	textInputTextEnd
+	textInputNewLine
 )
 
 var (
@@ -76,6 +87,13 @@ var (
 		textInputPaste:                "textInputPaste",
 		textInputCut:                  "textInputCut",
 		textInputUnfocus:              "textInputUnfocus",
+		textInputCursorUp:             "textInputCursorUp",
+		textInputCursorDown:           "textInputCursorDown",
+		textInputPageUp:               "textInputPageUp",
+		textInputPageDown:             "textInputPageDown",
+		textInputTextStart:            "textInputTextStart",
+		textInputTextEnd:              "textInputTextEnd",
+		textInputNewLine:              "textInputNewLine",
 	}
 )
 
@@ -100,14 +118,30 @@ type TextInput struct {
 	textPosY int
 
 	scrollOffset int
+	// mouseScrolled is set when the text is scrolled with the mouse wheel or by dragging the mouse past its edges,
+	// so the scroll offset stops following the cursor until the cursor moves or the value changes.
+	mouseScrolled bool
 
 	cursor              textInputCursor
 	cursorPosition      textInputCursorPosition
 	possibleCursorPosXs []int
+	// graphemes are the byte offsets of the value's grapheme clusters, so graphemes[position] is the offset of the cursor position
+	graphemes []int
 
 	pressed          bool
 	pressedPosition  textInputCursorPosition
 	releasedPosition textInputCursorPosition
+	// clickCount is the number of presses in a row, each within the multi-click interval after the previous one
+	clickCount int
+	// lastPressTime is the time of the last press. The next press counts as a part of a multi-click within the multi-click interval from it.
+	lastPressTime          time.Time
+	lastPressX, lastPressY int
+	// dragStart and dragEnd are the bounds of what the press selected, which stays selected while the mouse is dragged from it
+	dragStart, dragEnd textInputCursorPosition
+	// lastDragScrollTime is the time the text was last scrolled while the mouse was dragged past its edges
+	lastDragScrollTime time.Time
+
+	contextMenu textInputContextMenu
 
 	selecting     bool
 	selectingFrom textInputCursorPosition
@@ -116,11 +150,11 @@ type TextInput struct {
 	// selectionEnd is the max from selectingFrom and cursorPosition. Should be modified only by the updateSelectionBounds method.
 	selectionEnd textInputCursorPosition
 
-	ClickedEvent   *event.Event
-	PressedEvent   *event.Event
-	ReleasedEvent  *event.Event
-	ChangedEvent   *event.Event
-	SubmittedEvent *event.Event
+	ClickedEvent   *event.Event[*TextInputClickedEventArgs]
+	PressedEvent   *event.Event[*TextInputPressedEventArgs]
+	ReleasedEvent  *event.Event[*TextInputReleasedEventArgs]
+	ChangedEvent   *event.Event[*TextInputChangedEventArgs]
+	SubmittedEvent *event.Event[*TextInputSubmittedEventArgs]
 
 	submitOnUnfocus bool
 
@@ -128,9 +162,18 @@ type TextInput struct {
 
 	state textInputState
 
-	lastAction           textInputAction
-	readyForActionRepeat *atomic.Int32
-	readyForNewAction    *atomic.Bool
+	// inputSource is the input source passed to the last FireEvents call. It is read by the state machine.
+	inputSource input.InputSource
+
+	lastAction textInputAction
+	// actionRepeatDeadline is the time from which the last action can be repeated
+	actionRepeatDeadline time.Time
+	// newActionDeadline is the time from which a different action can be handled
+	newActionDeadline time.Time
+
+	history textInputHistory
+
+	mask textInputMask
 
 	actionKeys          []ebiten.Key
 	actionKeyHandlers   map[ebiten.Key]func() textInputAction
@@ -139,6 +182,13 @@ type TextInput struct {
 
 	onSubmitFunc        TextInputOnSubmitFunc
 	inputValidationFunc TextInputValidationFunc
+
+	// positionAt returns the cursor position closest to the mouse cursor's coordinates.
+	positionAt func(cursorPosX, cursorPosY int) textInputCursorPosition
+	// scroll scrolls the text by the mouse wheel's movement and returns whether the text moved.
+	scroll func(wheelX, wheelY float64) bool
+	// dragScroll scrolls the text towards the mouse cursor if it's dragged past the text's edges.
+	dragScroll func(cursorPosX, cursorPosY int)
 }
 
 type TextInputOnSubmitFunc func(string) string
@@ -156,12 +206,20 @@ type TextInputOptions struct {
 	Font          font.Face
 
 	Padding *Padding
+	Tooltip *Tooltip
 
 	OnSubmitFunc        TextInputOnSubmitFunc
 	InputValidationFunc TextInputValidationFunc
 
 	SubmitOnUnfocus bool
 
+	// Masked hides the value behind the mask characters, like in a password field. The masked value can't be copied or cut.
+	Masked bool
+	// MaskChar is the character shown instead of each character of the masked value. If not set, it's a bullet.
+	MaskChar rune
+	// MaskRevealDuration is how long the last typed character of the masked value is shown before it's masked too. If not set, it's masked right away.
+	MaskRevealDuration time.Duration
+
 	CursorOptions *TextInputCursorOptions
 }
 
@@ -199,40 +257,51 @@ type TextInputSubmittedEventArgs struct {
 type TextInputSubmittedHandlerFunc func(args *TextInputSubmittedEventArgs)
 
 func NewTextInput(options *TextInputOptions) *TextInput {
-	ti := &TextInput{
-		ClickedEvent:   &event.Event{},
-		PressedEvent:   &event.Event{},
-		ReleasedEvent:  &event.Event{},
-		ChangedEvent:   &event.Event{},
-		SubmittedEvent: &event.Event{},
+	ti := &TextInput{}
+	ti.init(options)
+
+	return ti
+}
+
+// init sets up the text input in place, so it can be embedded in other components, like the text area.
+func (ti *TextInput) init(options *TextInputOptions) {
+	ti.ClickedEvent = &event.Event[*TextInputClickedEventArgs]{}
+	ti.PressedEvent = &event.Event[*TextInputPressedEventArgs]{}
+	ti.ReleasedEvent = &event.Event[*TextInputReleasedEventArgs]{}
+	ti.ChangedEvent = &event.Event[*TextInputChangedEventArgs]{}
+	ti.SubmittedEvent = &event.Event[*TextInputSubmittedEventArgs]{}
 
-		color:         color.RGBA{230, 230, 230, 255},
-		colorDisabled: color.RGBA{150, 150, 150, 255},
-		colorHovered:  color.RGBA{250, 250, 250, 255},
-		font:          fontutils.DefaultFontFace,
-		metrics:       fontutils.NewMetrics(fontutils.DefaultFontFace.Metrics()),
+	ti.color = color.RGBA{230, 230, 230, 255}
+	ti.colorDisabled = color.RGBA{150, 150, 150, 255}
+	ti.colorHovered = color.RGBA{250, 250, 250, 255}
+	ti.font = fontutils.DefaultFontFace
+	ti.metrics = fontutils.NewMetrics(fontutils.DefaultFontFace.Metrics())
 
-		textPosX: 3,
+	ti.textPosX = 3
 
-		drawer: &DefaultTextInputDrawer{
-			Color:         color.RGBA{230, 230, 230, 255},
-			ColorDisabled: color.RGBA{150, 150, 150, 255},
-			ColorHovered:  color.RGBA{250, 250, 250, 255},
-		},
+	ti.drawer = &DefaultTextInputDrawer{
+		Color:         color.RGBA{230, 230, 230, 255},
+		ColorDisabled: color.RGBA{150, 150, 150, 255},
+		ColorHovered:  color.RGBA{250, 250, 250, 255},
+	}
+
+	ti.lastAction = textInputIdle
 
-		lastAction:           textInputIdle,
-		readyForActionRepeat: &atomic.Int32{},
-		readyForNewAction:    &atomic.Bool{},
+	ti.onSubmitFunc = func(s string) string { return s }
+	ti.inputValidationFunc = func(s string) (bool, string) { return true, s }
+
+	ti.selectingFrom = -1
 
-		onSubmitFunc:        func(s string) string { return s },
-		inputValidationFunc: func(s string) (bool, string) { return true, s },
+	ti.mask.char = textInputDefaultMaskChar
+	ti.mask.revealed = -1
 
-		selectingFrom: -1,
+	ti.positionAt = func(cursorPosX, cursorPosY int) textInputCursorPosition {
+		return ti.findClosestPossibleCursorPosition(cursorPosX)
 	}
+	ti.scroll = ti.scrollHorizontally
+	ti.dragScroll = ti.dragScrollHorizontally
 
 	ti.state = ti.idleStateFactory()
-	ti.readyForActionRepeat.Store(0)
-	ti.readyForNewAction.Store(true)
 
 	ti.actionKeys = []ebiten.Key{
 		ebiten.KeyControl,
@@ -277,8 +346,8 @@ func NewTextInput(options *TextInputOptions) *TextInput {
 		textInputRemoveSelection:      ti.RemoveSelection,
 		textInputSubmit:               ti.Submit,
 		textInputSelectAll:            ti.SelectAll,
-		textInputUndo:                 func() {},
-		textInputRedo:                 func() {},
+		textInputUndo:                 ti.Undo,
+		textInputRedo:                 ti.Redo,
 		textInputCopy:                 ti.Copy,
 		textInputPaste:                ti.Paste,
 		textInputCut:                  ti.Cut,
@@ -323,6 +392,10 @@ func NewTextInput(options *TextInputOptions) *TextInput {
 			ti.colorDisabled = colorutils.ToRGBA(options.ColorDisabled)
 		}
 
+		if options.ColorHovered != nil {
+			ti.colorHovered = colorutils.ToRGBA(options.ColorHovered)
+		}
+
 		if options.Font != nil {
 			ti.font = options.Font
 			ti.metrics = fontutils.NewMetrics(ti.font.Metrics())
@@ -343,11 +416,18 @@ func NewTextInput(options *TextInputOptions) *TextInput {
 		if options.CursorOptions != nil {
 			ti.cursor = *newTextInputCursor(options.CursorOptions)
 		}
+
+		ti.mask.masked = options.Masked
+		ti.mask.revealDuration = options.MaskRevealDuration
+
+		if options.MaskChar != 0 {
+			ti.mask.char = options.MaskChar
+		}
 	}
 
-	ti.setUpComponent(options)
+	ti.afterChange()
 
-	return ti
+	ti.setUpComponent(options)
 }
 
 func (ti *TextInput) setUpComponent(options *TextInputOptions) {
@@ -356,77 +436,98 @@ func (ti *TextInput) setUpComponent(options *TextInputOptions) {
 	if options != nil {
 		componentOptions = ComponentOptions{
 			Padding: options.Padding,
+			Tooltip: options.Tooltip,
 		}
 	}
 
 	ti.component.setUpComponent(&componentOptions)
+	ti.focusable = true
 
-	ti.component.AddCursorEnterHandler(func(args *ComponentCursorEnterEventArgs) {
+	ti.CursorEnterEvent.AddDefaultHandler(func(args *ComponentCursorEnterEventArgs) {
 		if !ti.disabled {
 			ti.hovering = true
 		}
 	})
 
-	ti.component.AddCursorExitHandler(func(args *ComponentCursorExitEventArgs) {
+	ti.CursorExitEvent.AddDefaultHandler(func(args *ComponentCursorExitEventArgs) {
 		ti.hovering = false
 	})
 
-	ti.component.AddFocusedHandler(func(args *ComponentFocusedEventArgs) {
-		if !ti.disabled {
-			ti.cursor.ResetBlink()
-
-			if !args.Focused {
-				ti.Deselect()
+	ti.FocusedEvent.AddDefaultHandler(func(args *ComponentFocusedEventArgs) {
+		if ti.disabled {
+			return
+		}
 
-				if ti.submitOnUnfocus {
-					ti.Submit()
-				}
-			}
+		switch {
+		case args.Focused:
+			ti.resetCursorBlink()
+		case ti.contextMenu.pressed:
+			// the focus moved to the context menu, whose items act on the selection
+		default:
+			ti.CloseContextMenu()
+			ti.unfocused()
 		}
 	})
 
-	ti.component.AddMouseButtonPressedHandler(func(args *ComponentMouseButtonPressedEventArgs) {
-		if ti.disabled || args.Button != ebiten.MouseButtonLeft {
+	ti.MouseWheelEvent.AddDefaultHandler(func(args *ComponentMouseWheelEventArgs) {
+		if ti.disabled {
 			return
 		}
 
-		ti.checkForShift()
+		// the wheel reaches the scroll containers the text input is in, unless it scrolled the text
+		if ti.scroll(args.WheelX, args.WheelY) {
+			args.StopPropagation()
+		}
+	})
 
-		ti.moveCursor(ti.findClosestPossibleCursorPosition())
+	ti.MouseButtonPressedEvent.AddDefaultHandler(func(args *ComponentMouseButtonPressedEventArgs) {
+		if ti.disabled || args.Button != ebiten.MouseButtonLeft {
+			return
+		}
 
-		if !ti.pressed {
+		if ti.pressed {
+			ti.drag(args.CursorPosX, args.CursorPosY)
+		} else {
+			ti.press(args.CursorPosX, args.CursorPosY)
 			ti.pressedPosition = ti.cursorPosition
 			ti.releasedPosition = -1
 		}
 
-		ti.pressed = true
-
-		if !ti.selecting {
-			ti.Deselect()
-			ti.selectingFrom = ti.pressedPosition
-		}
-
-		ti.eventManager.Fire(ti.PressedEvent, &TextInputPressedEventArgs{
+		event.Fire(ti.eventManager, ti.PressedEvent, &TextInputPressedEventArgs{
 			TextInput: ti,
 		})
 	})
 
-	ti.component.AddMouseButtonReleasedHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+	ti.MouseButtonReleasedEvent.AddDefaultHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+		if args.Button == ebiten.MouseButtonRight {
+			if !ti.disabled && args.Inside {
+				// the menu acts on the selection if it's clicked, otherwise the cursor is moved to the click first
+				ti.updateSelectionBounds()
+				if position := ti.positionAt(args.CursorPosX, args.CursorPosY); !ti.HasSelectedText() || position < ti.selectionStart || position > ti.selectionEnd {
+					ti.selectRange(position, position)
+				}
+
+				ti.OpenContextMenu(args.CursorPosX, args.CursorPosY)
+			}
+
+			return
+		}
+
 		if !ti.pressed || args.Button != ebiten.MouseButtonLeft {
 			return
 		}
 
-		ti.moveCursor(ti.findClosestPossibleCursorPosition())
+		ti.dragTo(ti.positionAt(ti.clampToBounds(args.CursorPosX, args.CursorPosY)))
 		ti.pressed = false
 		ti.releasedPosition = ti.cursorPosition
 
-		ti.eventManager.Fire(ti.ReleasedEvent, &TextInputReleasedEventArgs{
+		event.Fire(ti.eventManager, ti.ReleasedEvent, &TextInputReleasedEventArgs{
 			TextInput: ti,
 			Inside:    args.Inside,
 		})
 
 		if !ti.disabled && ti.pressedPosition == ti.releasedPosition {
-			ti.eventManager.Fire(ti.ClickedEvent, &TextInputClickedEventArgs{
+			event.Fire(ti.eventManager, ti.ClickedEvent, &TextInputClickedEventArgs{
 				TextInput: ti,
 			})
 		}
@@ -435,6 +536,17 @@ func (ti *TextInput) setUpComponent(options *TextInputOptions) {
 	})
 }
 
+// unfocused stops the cursor blinking, clears the selection and submits the value if the text input submits on unfocus.
+func (ti *TextInput) unfocused() {
+	ti.cursor.StopBlink()
+	ti.Deselect()
+	ti.mouseScrolled = false
+
+	if ti.submitOnUnfocus {
+		ti.Submit()
+	}
+}
+
 // SetHeight sets the component's height.
 func (ti *TextInput) SetHeight(height int) {
 	if height > 0 {
@@ -454,32 +566,27 @@ func (ti *TextInput) SetDimensions(width, height int) {
 	}
 }
 
-func (ti *TextInput) AddClickedHandler(f TextInputClickedHandlerFunc) *TextInput {
-	ti.ClickedEvent.AddHandler(func(args interface{}) { f(args.(*TextInputClickedEventArgs)) })
-
-	return ti
+func (ti *TextInput) AddClickedHandler(f TextInputClickedHandlerFunc) event.RemoveHandlerFunc {
+	return ti.ClickedEvent.AddHandler(event.HandlerFunc[*TextInputClickedEventArgs](f))
 }
 
-func (ti *TextInput) AddChangedHandler(f TextInputChangedHandlerFunc) *TextInput {
-	ti.ChangedEvent.AddHandler(func(args interface{}) { f(args.(*TextInputChangedEventArgs)) })
-
-	return ti
+func (ti *TextInput) AddChangedHandler(f TextInputChangedHandlerFunc) event.RemoveHandlerFunc {
+	return ti.ChangedEvent.AddHandler(event.HandlerFunc[*TextInputChangedEventArgs](f))
 }
 
-func (ti *TextInput) AddSubmittedHandler(f TextInputSubmittedHandlerFunc) *TextInput {
-	ti.SubmittedEvent.AddHandler(func(args interface{}) { f(args.(*TextInputSubmittedEventArgs)) })
-
-	return ti
+func (ti *TextInput) AddSubmittedHandler(f TextInputSubmittedHandlerFunc) event.RemoveHandlerFunc {
+	return ti.SubmittedEvent.AddHandler(event.HandlerFunc[*TextInputSubmittedEventArgs](f))
 }
 
 func (ti *TextInput) Value() string {
 	return ti.value
 }
 
-// SetValue sets the value of the text input.
+// SetValue sets the value of the text input. It clears the undo history, as the edits don't apply to the new value.
 func (ti *TextInput) SetValue(value string) {
 	if valid, valueAfterValidation := ti.inputValidationFunc(value); valid {
-		ti.setValue(valueAfterValidation)
+		ti.replaceValue(valueAfterValidation)
+		ti.ClearHistory()
 	}
 }
 
@@ -489,7 +596,7 @@ func (ti *TextInput) HasSelectedText() bool {
 
 func (ti *TextInput) GetSelectedText() string {
 	if ti.HasSelectedText() {
-		return ti.value[ti.selectionStart:ti.selectionEnd]
+		return ti.value[ti.offset(ti.selectionStart):ti.offset(ti.selectionEnd)]
 	}
 
 	return ""
@@ -550,45 +657,55 @@ func (ti *TextInput) End() {
 }
 
 func (ti *TextInput) Insert(chars []rune) {
-	newValue := ""
+	ti.insert(chars, false)
+}
 
+// insert inserts the characters at the cursor, replacing the selected text. Typed characters are undone together with the ones typed right before them.
+func (ti *TextInput) insert(chars []rune, typing bool) {
+	start, end := ti.offset(ti.cursorPosition), ti.offset(ti.cursorPosition)
 	if ti.HasSelectedText() {
-		newValue = ti.value[0:ti.selectionStart] + string(chars) + ti.value[ti.selectionEnd:]
-	} else {
-		newValue = ti.value[0:ti.cursorPosition] + string(chars) + ti.value[ti.cursorPosition:]
+		start, end = ti.offset(ti.selectionStart), ti.offset(ti.selectionEnd)
 	}
 
+	inserted := string(chars)
+	newValue := ti.value[0:start] + inserted + ti.value[end:]
+
 	if valid, valueAfterValidation := ti.inputValidationFunc(newValue); valid {
+		ti.saveUndoState(typing && !ti.HasSelectedText())
 		ti.setValue(valueAfterValidation)
-		if ti.HasSelectedText() {
-			ti.moveCursor(ti.selectionStart + textInputCursorPosition(len(chars)))
-		} else {
-			ti.moveCursor(ti.cursorPosition + textInputCursorPosition(len(chars)))
-		}
+		ti.moveCursor(ti.positionOfOffset(start + len(inserted)))
 		ti.Deselect()
+		ti.history.typing = typing
+
+		if typing {
+			ti.revealTyped()
+		}
+
 		ti.fireChangedEvent()
 	}
-
 }
 
 func (ti *TextInput) Delete() {
-	if ti.cursorPosition < textInputCursorPosition(len(ti.value)) {
-		ti.setValue(ti.value[0:ti.cursorPosition] + ti.value[ti.cursorPosition+1:])
+	if ti.cursorPosition < ti.length() {
+		ti.saveUndoState(false)
+		ti.setValue(ti.value[0:ti.offset(ti.cursorPosition)] + ti.value[ti.offset(ti.cursorPosition+1):])
 		ti.fireChangedEvent()
 	}
 }
 
 func (ti *TextInput) DeleteWord() {
-	if ti.cursorPosition < textInputCursorPosition(len(ti.value)) {
+	if ti.cursorPosition < ti.length() {
 		spaceToTheRightPosition := ti.findPositionAfterWord()
-		ti.setValue(ti.value[0:ti.cursorPosition] + ti.value[spaceToTheRightPosition:])
+		ti.saveUndoState(false)
+		ti.setValue(ti.value[0:ti.offset(ti.cursorPosition)] + ti.value[ti.offset(spaceToTheRightPosition):])
 		ti.fireChangedEvent()
 	}
 }
 
 func (ti *TextInput) DeleteToEnd() {
-	if ti.cursorPosition < textInputCursorPosition(len(ti.value)) {
-		ti.setValue(ti.value[0:ti.cursorPosition])
+	if ti.cursorPosition < ti.length() {
+		ti.saveUndoState(false)
+		ti.setValue(ti.value[0:ti.offset(ti.cursorPosition)])
 		ti.fireChangedEvent()
 		ti.End()
 	}
@@ -596,7 +713,8 @@ func (ti *TextInput) DeleteToEnd() {
 
 func (ti *TextInput) Backspace() {
 	if ti.cursorPosition > 0 {
-		ti.setValue(ti.value[0:ti.cursorPosition-1] + ti.value[ti.cursorPosition:])
+		ti.saveUndoState(false)
+		ti.setValue(ti.value[0:ti.offset(ti.cursorPosition-1)] + ti.value[ti.offset(ti.cursorPosition):])
 		ti.fireChangedEvent()
 		ti.CursorLeft()
 	}
@@ -605,15 +723,17 @@ func (ti *TextInput) Backspace() {
 func (ti *TextInput) BackspaceWord() {
 	if ti.cursorPosition > 0 {
 		spaceToTheLeftPosition := ti.findPositionBeforeWord()
-		ti.setValue(ti.value[0:spaceToTheLeftPosition] + ti.value[ti.cursorPosition:])
+		ti.saveUndoState(false)
+		ti.setValue(ti.value[0:ti.offset(spaceToTheLeftPosition)] + ti.value[ti.offset(ti.cursorPosition):])
 		ti.fireChangedEvent()
-		ti.Home()
+		ti.moveCursor(spaceToTheLeftPosition)
 	}
 }
 
 func (ti *TextInput) BackspaceToBegining() {
 	if ti.cursorPosition > 0 {
-		ti.setValue(ti.value[ti.cursorPosition:])
+		ti.saveUndoState(false)
+		ti.setValue(ti.value[ti.offset(ti.cursorPosition):])
 		ti.fireChangedEvent()
 		ti.moveCursor(0)
 	}
@@ -621,6 +741,7 @@ func (ti *TextInput) BackspaceToBegining() {
 
 func (ti *TextInput) RemoveLine() {
 	if ti.HasSelectedText() {
+		ti.saveUndoState(false)
 		ti.setValue("")
 		ti.fireChangedEvent()
 		ti.moveCursor(ti.selectionStart)
@@ -630,31 +751,48 @@ func (ti *TextInput) RemoveLine() {
 
 func (ti *TextInput) RemoveSelection() {
 	if ti.HasSelectedText() {
-		ti.setValue(ti.value[0:ti.selectionStart] + ti.value[ti.selectionEnd:])
+		ti.saveUndoState(false)
+		ti.setValue(ti.value[0:ti.offset(ti.selectionStart)] + ti.value[ti.offset(ti.selectionEnd):])
 		ti.fireChangedEvent()
 		ti.moveCursor(ti.selectionStart)
 		ti.Deselect()
 	}
 }
 
+// Submit fires the SubmittedEvent with the value returned by the on submit func. If the func changes the value, the undo history is cleared.
 func (ti *TextInput) Submit() {
-	ti.setValue(ti.onSubmitFunc(ti.value))
-	ti.eventManager.Fire(ti.SubmittedEvent, &TextInputSubmittedEventArgs{
+	if value := ti.onSubmitFunc(ti.value); value != ti.value {
+		ti.replaceValue(value)
+		ti.ClearHistory()
+	}
+
+	event.Fire(ti.eventManager, ti.SubmittedEvent, &TextInputSubmittedEventArgs{
 		TextInput: ti,
 		Text:      ti.value,
 	})
 }
 
 func (ti *TextInput) Unfocus() {
+	ti.CloseContextMenu()
 	ti.SetFocused(false)
 }
 
+// handlesEscape returns whether Escape unfocuses the text input, so it doesn't close the dialog the text input is in.
+func (ti *TextInput) handlesEscape() bool {
+	return ti.focused && !ti.disabled
+}
+
 func (ti *TextInput) SelectAll() {
-	ti.selectingFrom = 0
 	ti.End()
+	ti.selectingFrom = 0
 }
 
+// Copy copies the selected text to the clipboard, unless the value is masked.
 func (ti *TextInput) Copy() {
+	if ti.mask.masked {
+		return
+	}
+
 	if selectedText := ti.GetSelectedText(); len(selectedText) > 0 {
 		clipboard.Write(selectedText)
 	}
@@ -664,91 +802,88 @@ func (ti *TextInput) Paste() {
 	ti.Insert([]rune(clipboard.Read()))
 }
 
+// Cut copies the selected text to the clipboard and removes it, unless the value is masked.
 func (ti *TextInput) Cut() {
+	if ti.mask.masked {
+		return
+	}
+
 	ti.Copy()
 	ti.RemoveSelection()
 }
 
-func (ti *TextInput) Undo() {
-}
-
-func (ti *TextInput) Redo() {
-}
-
 func (ti *TextInput) fireChangedEvent() {
-	ti.eventManager.Fire(ti.ChangedEvent, &TextInputChangedEventArgs{
+	event.Fire(ti.eventManager, ti.ChangedEvent, &TextInputChangedEventArgs{
 		TextInput: ti,
 		Text:      ti.value,
 	})
 }
 
+// findPositionBeforeWord returns the position at the beginning of the word before the cursor, skipping the separators between them.
+// The masked value is a single word, so its words aren't given away.
 func (ti *TextInput) findPositionBeforeWord() textInputCursorPosition {
-	if ti.cursorPosition <= 0 {
+	if ti.mask.masked {
 		return 0
 	}
 
-	var tmpCursorPosition textInputCursorPosition
-
-	for i := int(ti.cursorPosition) - 1; i >= 0; i-- {
-		if i >= len(ti.value) {
-			continue
-		}
-
-		if i == 0 {
-			return 0
-		}
+	position := ti.cursorPosition
 
-		if !wordSeparatorRegex.MatchString(string(ti.value[i])) {
-			tmpCursorPosition = textInputCursorPosition(i)
-			break
-		}
+	for position > 0 && isWordSeparator(ti.grapheme(position-1)) {
+		position--
 	}
 
-	for i := int(tmpCursorPosition); i >= 0; i-- {
-		if i >= len(ti.value) {
-			continue
-		}
-		if wordSeparatorRegex.MatchString(string(ti.value[i])) {
-			return textInputCursorPosition(i + 1)
-		}
+	for position > 0 && !isWordSeparator(ti.grapheme(position-1)) {
+		position--
 	}
 
-	return 0
+	return position
 }
 
+// findPositionAfterWord returns the position at the end of the word after the cursor, skipping the separators between them.
 func (ti *TextInput) findPositionAfterWord() textInputCursorPosition {
-	if int(ti.cursorPosition) >= len(ti.value) {
-		return textInputCursorPosition(len(ti.value))
+	if ti.mask.masked {
+		return ti.length()
 	}
 
-	var tmpCursorPosition textInputCursorPosition
-
-	for i := int(ti.cursorPosition); i < len(ti.possibleCursorPosXs); i++ {
-		if i == len(ti.value) {
-			return textInputCursorPosition(len(ti.value))
-		}
+	position := ti.cursorPosition
 
-		if !wordSeparatorRegex.MatchString(string(ti.value[i])) {
-			tmpCursorPosition = textInputCursorPosition(i)
-			break
-		}
+	for position < ti.length() && isWordSeparator(ti.grapheme(position)) {
+		position++
 	}
 
-	for i := int(tmpCursorPosition); i < len(ti.possibleCursorPosXs); i++ {
-		if i == len(ti.possibleCursorPosXs)-1 || wordSeparatorRegex.MatchString(string(ti.value[i])) {
-			return textInputCursorPosition(i)
-		}
+	for position < ti.length() && !isWordSeparator(ti.grapheme(position)) {
+		position++
 	}
 
-	return textInputCursorPosition(len(ti.value))
+	return position
+}
+
+// length returns the number of grapheme clusters of the value, which is also the cursor position at its end.
+func (ti *TextInput) length() textInputCursorPosition {
+	return textInputCursorPosition(len(ti.graphemes) - 1)
+}
+
+// offset returns the byte offset of the cursor position in the value.
+func (ti *TextInput) offset(position textInputCursorPosition) int {
+	return ti.graphemes[position]
+}
+
+// positionOfOffset returns the cursor position at the byte offset, or the next one if the offset is inside a grapheme cluster.
+func (ti *TextInput) positionOfOffset(offset int) textInputCursorPosition {
+	return min(textInputCursorPosition(sort.SearchInts(ti.graphemes, offset)), ti.length())
+}
+
+// grapheme returns the grapheme cluster after the cursor position.
+func (ti *TextInput) grapheme(position textInputCursorPosition) string {
+	return ti.value[ti.graphemes[position]:ti.graphemes[position+1]]
 }
 
 func (ti *TextInput) cursorPosX() int {
 	return ti.possibleCursorPosXs[ti.cursorPosition] + ti.textPosX + ti.padding.Left - 1
 }
 
-func (ti *TextInput) findClosestPossibleCursorPosition() textInputCursorPosition {
-	cursorPosX := input.CursorPosX - int(ti.absPosX) - ti.textPosX - ti.padding.Left + 1
+func (ti *TextInput) findClosestPossibleCursorPosition(cursorPosX int) textInputCursorPosition {
+	cursorPosX = cursorPosX - int(ti.absPosX) - ti.textPosX - ti.padding.Left + ti.scrollOffset + 1
 
 	if cursorPosX <= ti.possibleCursorPosXs[0] {
 		return 0
@@ -794,38 +929,52 @@ func (ti *TextInput) findClosestPossibleCursorPosition() textInputCursorPosition
 	return getClosest(max, min, cursorPosX)
 }
 
-func (ti *TextInput) calcScrollOffset() int {
-	cursorPosX := ti.cursorPosX()
-	scrollOffsetLowerBound := 0
-	scrollOffsetUpperBound := fontutils.MeasureString(ti.value, ti.font) - (ti.width - ti.textPosX - ti.cursor.width - 2)
-	if scrollOffsetUpperBound < 0 {
-		scrollOffsetUpperBound = 0
+// scrollHorizontally scrolls the text by the mouse wheel's movement, by the vertical movement if the wheel isn't moved horizontally.
+// It returns whether the scroll offset changed.
+func (ti *TextInput) scrollHorizontally(wheelX, wheelY float64) bool {
+	wheel := wheelX
+	if wheel == 0 {
+		wheel = wheelY
 	}
 
-	applyBoundsToScrollOffset := func(offset int) int {
-		switch {
-		case offset < 0:
-			return 0
-		case offset < scrollOffsetLowerBound:
-			return scrollOffsetLowerBound
-		case offset > scrollOffsetUpperBound:
-			return scrollOffsetUpperBound
-		default:
-			return offset
-		}
-	}
+	scrollOffset := ti.scrollOffset
+	ti.scrollOffset = ti.boundScrollOffset(ti.scrollOffset - int(math.Round(wheel*textInputWheelScrollSpeed)))
+	ti.mouseScrolled = true
+
+	return ti.scrollOffset != scrollOffset
+}
+
+func (ti *TextInput) calcScrollOffset() int {
+	cursorPosX := ti.cursorPosX()
 
-	ti.scrollOffset = applyBoundsToScrollOffset(ti.scrollOffset)
+	ti.scrollOffset = ti.boundScrollOffset(ti.scrollOffset)
 
-	if cursorPosX > ti.scrollOffset && cursorPosX < ti.width+ti.scrollOffset-1 {
+	if ti.mouseScrolled || cursorPosX > ti.scrollOffset && cursorPosX < ti.width+ti.scrollOffset-1 {
 		return ti.scrollOffset
 	}
 
-	ti.scrollOffset = applyBoundsToScrollOffset(cursorPosX - ti.width/2)
+	ti.scrollOffset = ti.boundScrollOffset(cursorPosX - ti.width/2)
 
 	return ti.scrollOffset
 }
 
+// boundScrollOffset limits the scroll offset to the range in which the text fills the input.
+func (ti *TextInput) boundScrollOffset(offset int) int {
+	scrollOffsetUpperBound := ti.possibleCursorPosXs[len(ti.possibleCursorPosXs)-1] - (ti.width - ti.textPosX - ti.cursor.width - 2)
+	if scrollOffsetUpperBound < 0 {
+		scrollOffsetUpperBound = 0
+	}
+
+	switch {
+	case offset < 0:
+		return 0
+	case offset > scrollOffsetUpperBound:
+		return scrollOffsetUpperBound
+	default:
+		return offset
+	}
+}
+
 func (ti *TextInput) moveCursor(position textInputCursorPosition) {
 	if position == ti.cursorPosition {
 		return
@@ -842,7 +991,16 @@ func (ti *TextInput) moveCursor(position textInputCursorPosition) {
 		ti.End()
 	default:
 		ti.cursorPosition = position
-		ti.cursor.ResetBlink()
+		ti.history.typing = false
+		ti.mouseScrolled = false
+		ti.resetCursorBlink()
+	}
+}
+
+// resetCursorBlink shows the cursor of the focused text input and restarts its blinking.
+func (ti *TextInput) resetCursorBlink() {
+	if ti.focused {
+		ti.cursor.ResetBlink(ti.eventManager.Scheduler())
 	}
 }
 
@@ -861,23 +1019,41 @@ func (ti *TextInput) setValue(value string) {
 	ti.afterChange()
 }
 
+// replaceValue sets a value that isn't an edit at the cursor, keeping the cursor and the selection within the new value.
+func (ti *TextInput) replaceValue(value string) {
+	ti.setValue(value)
+
+	end := ti.length()
+	ti.cursorPosition = min(ti.cursorPosition, end)
+	ti.selectingFrom = min(ti.selectingFrom, end)
+}
+
 func (ti *TextInput) afterChange() {
+	ti.mouseScrolled = false
 	ti.textPosY = ti.metrics.Ascent - ti.metrics.Descent - 1
-	ti.possibleCursorPosXs = make([]int, len(ti.value)+1)
+	ti.graphemes = graphemeBoundaries(ti.value)
+	ti.mask.revealed = -1
+	ti.measureText()
+}
+
+// measureText measures the cursor positions between the shown grapheme clusters.
+func (ti *TextInput) measureText() {
+	ti.possibleCursorPosXs = make([]int, len(ti.graphemes))
 	ti.possibleCursorPosXs[0] = 0
 
-	for i, c := range ti.value {
-		ti.possibleCursorPosXs[i+1] = ti.possibleCursorPosXs[i] + fontutils.MeasureString(string(c), ti.font)
+	for i := 1; i < len(ti.graphemes); i++ {
+		position := textInputCursorPosition(i)
+		ti.possibleCursorPosXs[i] = ti.possibleCursorPosXs[i-1] + fontutils.MeasureString(ti.shownText(position-1, position), ti.font)
 	}
 }
 
 func (ti *TextInput) actionKeyPressed() (bool, ebiten.Key) {
 	for key := range ti.modifierKeysPressed {
-		ti.modifierKeysPressed[key] = input.KeyPressed[key]
+		ti.modifierKeysPressed[key] = ti.inputSource.KeyPressed(key)
 	}
 
 	for _, key := range ti.actionKeys {
-		if input.KeyPressed[key] {
+		if ti.inputSource.KeyPressed(key) {
 			return true, key
 		}
 	}
@@ -901,13 +1077,13 @@ func (ti *TextInput) handleKeyLeft() textInputAction {
 	switch {
 	case ti.modifierKeysPressed[ebiten.KeyAlt] && (ti.modifierKeysPressed[ebiten.KeyControl] || ti.modifierKeysPressed[ebiten.KeyMeta]):
 		return textInputIdle
-	case ti.modifierKeysPressed[ebiten.KeyAlt] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyAlt] && ti.inputSource.OS().IsMacOS():
 		return textInputWordLeft
-	case ti.modifierKeysPressed[ebiten.KeyControl] && !input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyControl] && !ti.inputSource.OS().IsMacOS():
 		return textInputWordLeft
-	case ti.modifierKeysPressed[ebiten.KeyMeta] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyMeta] && ti.inputSource.OS().IsMacOS():
 		return textInputHome
-	case ti.modifierKeysPressed[ebiten.KeyMeta] && !input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyMeta] && !ti.inputSource.OS().IsMacOS():
 		return textInputIdle
 	default:
 		return textInputCursorLeft
@@ -920,13 +1096,13 @@ func (ti *TextInput) handleKeyRight() textInputAction {
 	switch {
 	case ti.modifierKeysPressed[ebiten.KeyAlt] && (ti.modifierKeysPressed[ebiten.KeyControl] || ti.modifierKeysPressed[ebiten.KeyMeta]):
 		return textInputIdle
-	case ti.modifierKeysPressed[ebiten.KeyAlt] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyAlt] && ti.inputSource.OS().IsMacOS():
 		return textInputWordRight
-	case ti.modifierKeysPressed[ebiten.KeyControl] && !input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyControl] && !ti.inputSource.OS().IsMacOS():
 		return textInputWordRight
-	case ti.modifierKeysPressed[ebiten.KeyMeta] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyMeta] && ti.inputSource.OS().IsMacOS():
 		return textInputEnd
-	case ti.modifierKeysPressed[ebiten.KeyMeta] && !input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyMeta] && !ti.inputSource.OS().IsMacOS():
 		return textInputIdle
 	default:
 		return textInputCursorRight
@@ -951,15 +1127,15 @@ func (ti *TextInput) handleKeyDelete() textInputAction {
 	switch {
 	case ti.HasSelectedText():
 		return textInputRemoveSelection
-	case ti.modifierKeysPressed[ebiten.KeyShift] && !input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyShift] && !ti.inputSource.OS().IsMacOS():
 		return textInputRemoveLine
-	case ti.modifierKeysPressed[ebiten.KeyShift] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyShift] && ti.inputSource.OS().IsMacOS():
 		return textInputIdle
-	case ti.modifierKeysPressed[ebiten.KeyControl] && !input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyControl] && !ti.inputSource.OS().IsMacOS():
 		return textInputDeleteWord
-	case ti.modifierKeysPressed[ebiten.KeyAlt] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyAlt] && ti.inputSource.OS().IsMacOS():
 		return textInputDeleteWord
-	case ti.modifierKeysPressed[ebiten.KeyMeta] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyMeta] && ti.inputSource.OS().IsMacOS():
 		return textInputDeleteToEnd
 	default:
 		return textInputDelete
@@ -972,11 +1148,11 @@ func (ti *TextInput) handleKeyBackspace() textInputAction {
 	switch {
 	case ti.HasSelectedText():
 		return textInputRemoveSelection
-	case ti.modifierKeysPressed[ebiten.KeyControl] && !input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyControl] && !ti.inputSource.OS().IsMacOS():
 		return textInputBackspaceWord
-	case ti.modifierKeysPressed[ebiten.KeyAlt] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyAlt] && ti.inputSource.OS().IsMacOS():
 		return textInputBackspaceWord
-	case ti.modifierKeysPressed[ebiten.KeyMeta] && input.OSMacOS():
+	case ti.modifierKeysPressed[ebiten.KeyMeta] && ti.inputSource.OS().IsMacOS():
 		return textInputBackspaceToBeginning
 	default:
 		return textInputBackspace
@@ -1004,32 +1180,32 @@ func (ti *TextInput) handleKeyEscape() textInputAction {
 }
 
 func (ti *TextInput) handleKeyCtrl() textInputAction {
-	if input.OSMacOS() {
+	if ti.inputSource.OS().IsMacOS() {
 		return textInputIdle
 	}
 
 	switch {
-	case input.KeyPressed[ebiten.KeyLeft]:
+	case ti.inputSource.KeyPressed(ebiten.KeyLeft):
 		return ti.handleKeyLeft()
-	case input.KeyPressed[ebiten.KeyRight]:
+	case ti.inputSource.KeyPressed(ebiten.KeyRight):
 		return ti.handleKeyRight()
-	case input.KeyPressed[ebiten.KeyBackspace]:
+	case ti.inputSource.KeyPressed(ebiten.KeyBackspace):
 		return ti.handleKeyBackspace()
-	case input.KeyPressed[ebiten.KeyDelete]:
+	case ti.inputSource.KeyPressed(ebiten.KeyDelete):
 		return ti.handleKeyDelete()
-	case input.KeyPressed[ebiten.KeyA]:
+	case ti.inputSource.KeyPressed(ebiten.KeyA):
 		return textInputSelectAll
-	case input.KeyPressed[ebiten.KeyShift] && input.KeyPressed[ebiten.KeyZ]:
+	case ti.inputSource.KeyPressed(ebiten.KeyShift) && ti.inputSource.KeyPressed(ebiten.KeyZ):
 		return textInputRedo
-	case input.KeyPressed[ebiten.KeyY]:
+	case ti.inputSource.KeyPressed(ebiten.KeyY):
 		return textInputRedo
-	case input.KeyPressed[ebiten.KeyZ]:
+	case ti.inputSource.KeyPressed(ebiten.KeyZ):
 		return textInputUndo
-	case input.KeyPressed[ebiten.KeyC]:
+	case ti.inputSource.KeyPressed(ebiten.KeyC):
 		return textInputCopy
-	case input.KeyPressed[ebiten.KeyV]:
+	case ti.inputSource.KeyPressed(ebiten.KeyV):
 		return textInputPaste
-	case input.KeyPressed[ebiten.KeyX]:
+	case ti.inputSource.KeyPressed(ebiten.KeyX):
 		return textInputCut
 	default:
 		return textInputIdle
@@ -1037,30 +1213,30 @@ func (ti *TextInput) handleKeyCtrl() textInputAction {
 }
 
 func (ti *TextInput) handleKeyMeta() textInputAction {
-	if !input.OSMacOS() {
+	if !ti.inputSource.OS().IsMacOS() {
 		return textInputIdle
 	}
 
 	switch {
-	case input.KeyPressed[ebiten.KeyLeft]:
+	case ti.inputSource.KeyPressed(ebiten.KeyLeft):
 		return ti.handleKeyLeft()
-	case input.KeyPressed[ebiten.KeyRight]:
+	case ti.inputSource.KeyPressed(ebiten.KeyRight):
 		return ti.handleKeyRight()
-	case input.KeyPressed[ebiten.KeyBackspace]:
+	case ti.inputSource.KeyPressed(ebiten.KeyBackspace):
 		return ti.handleKeyBackspace()
-	case input.KeyPressed[ebiten.KeyDelete]:
+	case ti.inputSource.KeyPressed(ebiten.KeyDelete):
 		return ti.handleKeyDelete()
-	case input.KeyPressed[ebiten.KeyA]:
+	case ti.inputSource.KeyPressed(ebiten.KeyA):
 		return textInputSelectAll
-	case input.KeyPressed[ebiten.KeyShift] && input.KeyPressed[ebiten.KeyZ]:
+	case ti.inputSource.KeyPressed(ebiten.KeyShift) && ti.inputSource.KeyPressed(ebiten.KeyZ):
 		return textInputRedo
-	case input.KeyPressed[ebiten.KeyZ]:
+	case ti.inputSource.KeyPressed(ebiten.KeyZ):
 		return textInputUndo
-	case input.KeyPressed[ebiten.KeyC]:
+	case ti.inputSource.KeyPressed(ebiten.KeyC):
 		return textInputCopy
-	case input.KeyPressed[ebiten.KeyV]:
+	case ti.inputSource.KeyPressed(ebiten.KeyV):
 		return textInputPaste
-	case input.KeyPressed[ebiten.KeyX]:
+	case ti.inputSource.KeyPressed(ebiten.KeyX):
 		return textInputCut
 	default:
 		return textInputIdle
@@ -1086,8 +1262,8 @@ func (ti *TextInput) idleStateFactory() textInputState {
 			return ti.idleStateFactory()
 		}
 
-		if len(input.InputChars) > 0 {
-			return ti.inputStateFactory(input.InputChars)
+		if chars := ti.inputSource.InputChars(); len(chars) > 0 {
+			return ti.inputStateFactory(chars)
 		}
 
 		if pressed, key := ti.actionKeyPressed(); pressed {
@@ -1104,7 +1280,7 @@ func (ti *TextInput) inputStateFactory(chars []rune) textInputState {
 			return ti.idleStateFactory()
 		}
 
-		ti.Insert(chars)
+		ti.insert(chars, true)
 
 		if pressed, key := ti.actionKeyPressed(); pressed {
 			return ti.actionStateFactory(ti.handleActionKey(key))
@@ -1129,32 +1305,54 @@ func (ti *TextInput) actionStateFactory(action textInputAction) textInputState {
 			return ti.idleStateFactory()
 		}
 
+		scheduler := ti.eventManager.Scheduler()
+
+		// without a scheduler, there's no clock to delay the repeats with, so the action runs once per key press
+		if scheduler == nil {
+			if ti.lastAction != action {
+				ti.actionHandlers[action]()
+				ti.lastAction = action
+			}
+
+			return ti.idleStateFactory()
+		}
+
+		now := scheduler.Now()
+
 		delay := textInputActionRepeatDelay
 		if ti.lastAction == action {
 			delay = textInputActionRepeatInterval
 
-			if ti.readyForActionRepeat.Load() > 0 {
+			if now.Before(ti.actionRepeatDeadline) {
 				return ti.idleStateFactory()
 			}
-		} else if !ti.readyForNewAction.Load() {
+		} else if now.Before(ti.newActionDeadline) {
 			return ti.idleStateFactory()
 		}
 
 		ti.actionHandlers[action]()
 
 		ti.lastAction = action
+		ti.actionRepeatDeadline = now.Add(delay)
+		ti.newActionDeadline = now.Add(textInputDelayBeforeNewAction)
 
-		ti.readyForActionRepeat.Add(1)
-		time.AfterFunc(delay, func() {
-			ti.readyForActionRepeat.Add(-1)
-		})
+		return ti.idleStateFactory()
+	}
+}
 
-		ti.readyForNewAction.Store(false)
-		time.AfterFunc(textInputDelayBeforeNewAction, func() {
-			ti.readyForNewAction.Store(true)
-		})
+// FireEvents checks if the mouse cursor is inside the component, fires events accordingly and handles keyboard input.
+func (ti *TextInput) FireEvents(in input.InputSource) {
+	ti.inputSource = in
 
-		return ti.idleStateFactory()
+	if ti.hidden || ti.disabled {
+		ti.CloseContextMenu()
+	}
+
+	ti.component.FireEvents(in)
+	ti.fireContextMenuEvents(in)
+
+	if !ti.disabled && !ti.hidden {
+		ti.state = ti.state(ti)
 	}
 }
 
@@ -1162,18 +1360,18 @@ func (ti *TextInput) drawText(clr color.RGBA) {
 	textStartPosX := ti.textPosX - ti.scrollOffset + ti.padding.Left
 
 	if !ti.HasSelectedText() {
-		text.Draw(ti.image, ti.value, ti.font, textStartPosX, ti.textPosY+ti.padding.Top, clr)
+		text.Draw(ti.image, ti.shownText(0, ti.length()), ti.font, textStartPosX, ti.textPosY+ti.padding.Top, clr)
 		return
 	}
 
 	if ti.selectionStart > 0 {
-		text.Draw(ti.image, ti.value[0:ti.selectionStart], ti.font, textStartPosX, ti.textPosY+ti.padding.Top, clr)
+		text.Draw(ti.image, ti.shownText(0, ti.selectionStart), ti.font, textStartPosX, ti.textPosY+ti.padding.Top, clr)
 	}
 
-	text.Draw(ti.image, ti.value[ti.selectionStart:ti.selectionEnd], ti.font, textStartPosX+ti.possibleCursorPosXs[ti.selectionStart], ti.textPosY+ti.padding.Top, colorutils.Invert(clr))
+	text.Draw(ti.image, ti.shownText(ti.selectionStart, ti.selectionEnd), ti.font, textStartPosX+ti.possibleCursorPosXs[ti.selectionStart], ti.textPosY+ti.padding.Top, colorutils.Invert(clr))
 
-	if int(ti.selectionEnd) <= len(ti.value)-1 {
-		text.Draw(ti.image, ti.value[ti.selectionEnd:], ti.font, textStartPosX+ti.possibleCursorPosXs[ti.selectionEnd], ti.textPosY+ti.padding.Top, clr)
+	if ti.selectionEnd < ti.length() {
+		text.Draw(ti.image, ti.shownText(ti.selectionEnd, ti.length()), ti.font, textStartPosX+ti.possibleCursorPosXs[ti.selectionEnd], ti.textPosY+ti.padding.Top, clr)
 	}
 }
 
@@ -1182,10 +1380,6 @@ func (ti *TextInput) Draw() *ebiten.Image {
 		return ti.image
 	}
 
-	if !ti.disabled {
-		ti.state = ti.state(ti)
-	}
-
 	ti.updateSelectionBounds()
 
 	ti.drawer.Draw(ti)
@@ -1196,7 +1390,7 @@ func (ti *TextInput) Draw() *ebiten.Image {
 		op := &ebiten.DrawImageOptions{}
 		op.GeoM.Translate(float64(ti.cursorPosX()-ti.scrollOffset), float64(2+ti.padding.Top))
 		ti.image.DrawImage(ti.cursor.Draw(), op)
-	} else {
+	} else if !ti.mouseScrolled {
 		ti.scrollOffset = 0
 	}
 
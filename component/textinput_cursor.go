@@ -4,13 +4,22 @@ import (
 	"image/color"
 
 	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
+// the cursor blinks, shown and hidden for the given numbers of frames
+const (
+	textInputCursorVisibleFrames = 45
+	textInputCursorHiddenFrames  = 35
+)
+
 type textInputCursor struct {
 	component
-	drawer     TextInputCursorDrawer
-	frameCount int
+	drawer TextInputCursorDrawer
+
+	visible    bool
+	blinkTimer *timer.Handle
 }
 
 type TextInputCursorOptions struct {
@@ -25,6 +34,7 @@ func newTextInputCursor(options *TextInputCursorOptions) *textInputCursor {
 		drawer: &DefaultTextInputCursorDrawer{
 			Color: color.RGBA{230, 230, 230, 255},
 		},
+		visible: true,
 	}
 
 	width := 1
@@ -58,16 +68,35 @@ func (tic *textInputCursor) setUpComponent() {
 	tic.component.setUpComponent(&componentOptions)
 }
 
-func (tic *textInputCursor) ResetBlink() {
-	tic.frameCount = 0
+// ResetBlink shows the cursor and restarts its blinking with the scheduler.
+func (tic *textInputCursor) ResetBlink(scheduler *timer.Scheduler) {
+	tic.blinkTimer.Cancel()
+	tic.visible = true
+	tic.blinkTimer = scheduler.AfterFrames(textInputCursorVisibleFrames, func() {
+		tic.blink(scheduler)
+	})
+}
+
+// StopBlink shows the cursor and stops its blinking.
+func (tic *textInputCursor) StopBlink() {
+	tic.blinkTimer.Cancel()
+	tic.visible = true
 }
 
-func (tic *textInputCursor) incFrameCount() {
-	tic.frameCount = (tic.frameCount + 1) % 80
+func (tic *textInputCursor) blink(scheduler *timer.Scheduler) {
+	tic.visible = !tic.visible
+
+	frames := textInputCursorVisibleFrames
+	if !tic.visible {
+		frames = textInputCursorHiddenFrames
+	}
+
+	tic.blinkTimer = scheduler.AfterFrames(frames, func() {
+		tic.blink(scheduler)
+	})
 }
 
 func (tic *textInputCursor) Draw() *ebiten.Image {
-	tic.incFrameCount()
 	tic.drawer.Draw(tic)
 	return tic.image
 }
@@ -0,0 +1,104 @@
+package component
+
+// textInputHistoryLimit is the maximum number of edits the text input can undo.
+var textInputHistoryLimit = 100
+
+// textInputHistory is the undo and redo history of the text input's edits.
+type textInputHistory struct {
+	undoStack []textInputHistoryState
+	redoStack []textInputHistoryState
+
+	// typing is set while the cursor stays where the last typed characters left it, so the next typed characters are undone with them
+	typing bool
+}
+
+// textInputHistoryState is the text input's value with the cursor and the selection, from before or after an edit.
+type textInputHistoryState struct {
+	value          string
+	cursorPosition textInputCursorPosition
+	selectingFrom  textInputCursorPosition
+}
+
+// CanUndo returns whether there are edits to undo.
+func (ti *TextInput) CanUndo() bool {
+	return len(ti.history.undoStack) > 0
+}
+
+// CanRedo returns whether there are undone edits to redo.
+func (ti *TextInput) CanRedo() bool {
+	return len(ti.history.redoStack) > 0
+}
+
+// Undo reverts the last edit, restoring the cursor and the selection from before it.
+func (ti *TextInput) Undo() {
+	if !ti.CanUndo() {
+		return
+	}
+
+	ti.history.redoStack = append(ti.history.redoStack, ti.historyState())
+	ti.restoreHistoryState(ti.popHistoryState(&ti.history.undoStack))
+}
+
+// Redo reapplies the last undone edit.
+func (ti *TextInput) Redo() {
+	if !ti.CanRedo() {
+		return
+	}
+
+	ti.history.undoStack = append(ti.history.undoStack, ti.historyState())
+	ti.restoreHistoryState(ti.popHistoryState(&ti.history.redoStack))
+}
+
+// ClearHistory forgets the edits, so they can't be undone.
+func (ti *TextInput) ClearHistory() {
+	ti.history = textInputHistory{}
+}
+
+// saveUndoState saves the state from before an edit, unless the typed characters are coalesced with the previous ones.
+func (ti *TextInput) saveUndoState(typing bool) {
+	ti.history.redoStack = nil
+
+	coalesced := typing && ti.history.typing
+	ti.history.typing = false
+
+	if coalesced {
+		return
+	}
+
+	ti.history.undoStack = append(ti.history.undoStack, ti.historyState())
+	if len(ti.history.undoStack) > textInputHistoryLimit {
+		ti.history.undoStack = ti.history.undoStack[len(ti.history.undoStack)-textInputHistoryLimit:]
+	}
+}
+
+func (ti *TextInput) historyState() textInputHistoryState {
+	selectingFrom := ti.selectingFrom
+	if selectingFrom == ti.cursorPosition {
+		selectingFrom = -1
+	}
+
+	return textInputHistoryState{
+		value:          ti.value,
+		cursorPosition: ti.cursorPosition,
+		selectingFrom:  selectingFrom,
+	}
+}
+
+func (ti *TextInput) popHistoryState(stack *[]textInputHistoryState) textInputHistoryState {
+	state := (*stack)[len(*stack)-1]
+	*stack = (*stack)[:len(*stack)-1]
+
+	return state
+}
+
+func (ti *TextInput) restoreHistoryState(state textInputHistoryState) {
+	ti.setValue(state.value)
+
+	ti.selecting = false
+	ti.selectingFrom = state.selectingFrom
+	ti.cursorPosition = state.cursorPosition
+	ti.history.typing = false
+	ti.resetCursorBlink()
+
+	ti.fireChangedEvent()
+}
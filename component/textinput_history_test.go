@@ -0,0 +1,150 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/input"
+	"github.com/matryer/is"
+)
+
+// typeChars types the characters into the focused text input, a character per frame.
+func typeChars(t *testing.T, ti *TextInput, in *input.FakeInputSource, chars string) {
+	t.Helper()
+
+	for _, char := range chars {
+		in.TypeChars(char)
+		in.Update()
+		handleState(t, ti)
+		handleState(t, ti)
+	}
+}
+
+func TestTextInput_UndoTyping(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.focused = true
+
+	typeChars(t, ti, in, "abc")
+	is.Equal(ti.Value(), "abc")
+
+	ti.Undo()
+	is.Equal(ti.Value(), "") // the typed characters are undone in one step
+	is.Equal(ti.cursorPosition, textInputCursorPosition(0))
+
+	ti.Redo()
+	is.Equal(ti.Value(), "abc")
+	is.Equal(ti.cursorPosition, textInputCursorPosition(3))
+
+	ti.CursorLeft()
+	typeChars(t, ti, in, "d")
+	is.Equal(ti.Value(), "abdc")
+
+	ti.Undo()
+	is.Equal(ti.Value(), "abc") // moving the cursor starts a new step
+	is.Equal(ti.cursorPosition, textInputCursorPosition(2))
+}
+
+func TestTextInput_UndoDeletion(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.focused = true
+
+	typeChars(t, ti, in, "abc")
+	ti.Backspace()
+	typeChars(t, ti, in, "d")
+	is.Equal(ti.Value(), "abd")
+
+	ti.Undo()
+	is.Equal(ti.Value(), "ab")
+
+	ti.Undo()
+	is.Equal(ti.Value(), "abc")
+
+	ti.Undo()
+	is.Equal(ti.Value(), "")
+	is.True(!ti.CanUndo())
+}
+
+func TestTextInput_UndoRestoresSelection(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.Insert([]rune("qwerty"))
+
+	ti.selectingFrom = 1
+	ti.cursorPosition = 4
+	ti.updateSelectionBounds()
+
+	ti.RemoveSelection()
+	is.Equal(ti.Value(), "qty")
+
+	ti.Undo()
+	ti.updateSelectionBounds()
+	is.Equal(ti.Value(), "qwerty")
+	is.Equal(ti.GetSelectedText(), "wer")
+
+	ti.Insert([]rune("a"))
+	is.Equal(ti.Value(), "qaty")
+	is.True(!ti.CanRedo()) // a new edit drops the undone edits
+
+	ti.Undo()
+	ti.Undo()
+	is.Equal(ti.Value(), "")
+}
+
+func TestTextInput_UndoFiresChangedEvent(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+
+	var changes []string
+	ti.AddChangedHandler(func(args *TextInputChangedEventArgs) {
+		changes = append(changes, args.Text)
+	})
+
+	ti.Insert([]rune("abc"))
+	ti.Undo()
+	ti.Redo()
+	ti.Redo() // nothing to redo
+	ti.eventManager.HandleFired()
+
+	is.Equal(changes, []string{"abc", "", "abc"})
+}
+
+func TestTextInput_HistoryLimit(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+
+	for i := 0; i < textInputHistoryLimit+10; i++ {
+		ti.Insert([]rune("a"))
+	}
+
+	undone := 0
+	for ti.CanUndo() {
+		ti.Undo()
+		undone++
+	}
+
+	is.Equal(undone, textInputHistoryLimit)
+	is.Equal(len(ti.Value()), 10)
+}
+
+func TestTextInput_SetValueClearsHistory(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.Insert([]rune("abc"))
+	is.True(ti.CanUndo())
+
+	ti.SetValue("xyz")
+	is.True(!ti.CanUndo())
+}
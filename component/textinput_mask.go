@@ -0,0 +1,101 @@
+package component
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fglo/chopstiqs/timer"
+)
+
+// textInputDefaultMaskChar is the character the masked text input shows instead of each character of its value.
+const textInputDefaultMaskChar = '•'
+
+// textInputMask is the state of the masked text input, like a password field, which hides its value behind the mask characters.
+type textInputMask struct {
+	masked bool
+	char   rune
+	// shown is set while the masked value is shown with the show/hide toggle
+	shown bool
+
+	revealDuration time.Duration
+	// revealed is the position of the last typed character, which is shown for the reveal duration, or -1
+	revealed    textInputCursorPosition
+	revealTimer *timer.Handle
+}
+
+// Masked returns whether the text input hides its value behind the mask characters.
+func (ti *TextInput) Masked() bool {
+	return ti.mask.masked
+}
+
+// SetMasked sets whether the text input hides its value behind the mask characters. The masked value can't be copied or cut.
+func (ti *TextInput) SetMasked(masked bool) {
+	ti.mask.masked = masked
+	ti.mask.revealed = -1
+	ti.measureText()
+}
+
+// ValueShown returns whether the masked text input shows its value.
+func (ti *TextInput) ValueShown() bool {
+	return ti.mask.shown
+}
+
+// SetValueShown shows or hides the value of the masked text input, like the show/hide toggle of a password field.
+func (ti *TextInput) SetValueShown(shown bool) {
+	ti.mask.shown = shown
+	ti.mask.revealed = -1
+	ti.measureText()
+}
+
+// ToggleValueShown shows the masked value if it's hidden, and hides it if it's shown.
+func (ti *TextInput) ToggleValueShown() {
+	ti.SetValueShown(!ti.mask.shown)
+}
+
+// masking returns whether the value is hidden behind the mask characters.
+func (ti *TextInput) masking() bool {
+	return ti.mask.masked && !ti.mask.shown
+}
+
+// shownText returns the text shown between the cursor positions. The masked value is shown as the mask characters,
+// except for the last typed character while it's revealed.
+func (ti *TextInput) shownText(from, to textInputCursorPosition) string {
+	if !ti.masking() {
+		return ti.value[ti.offset(from):ti.offset(to)]
+	}
+
+	var text strings.Builder
+	for position := from; position < to; position++ {
+		if position == ti.mask.revealed {
+			text.WriteString(ti.grapheme(position))
+		} else {
+			text.WriteRune(ti.mask.char)
+		}
+	}
+
+	return text.String()
+}
+
+// revealTyped shows the character typed before the cursor for the reveal duration, if the value is masked.
+func (ti *TextInput) revealTyped() {
+	scheduler := ti.eventManager.Scheduler()
+	if !ti.masking() || ti.mask.revealDuration <= 0 || ti.cursorPosition == 0 || scheduler == nil {
+		return
+	}
+
+	ti.mask.revealed = ti.cursorPosition - 1
+	ti.measureText()
+
+	ti.mask.revealTimer.Cancel()
+	ti.mask.revealTimer = scheduler.After(ti.mask.revealDuration, ti.hideRevealed)
+}
+
+// hideRevealed masks the revealed character again.
+func (ti *TextInput) hideRevealed() {
+	if ti.mask.revealed == -1 {
+		return
+	}
+
+	ti.mask.revealed = -1
+	ti.measureText()
+}
@@ -0,0 +1,86 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	fontutils "github.com/fglo/chopstiqs/font"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/matryer/is"
+)
+
+func newTestMaskedTextInput(in input.InputSource, options TextInputOptions) *TextInput {
+	options.Masked = true
+	ti := NewTextInput(&options)
+	ti.SetEventManager(newTestEventManager())
+	ti.inputSource = in
+
+	return ti
+}
+
+func TestTextInput_Masked(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestMaskedTextInput(in, TextInputOptions{})
+	ti.SetValue("pass word")
+
+	is.Equal(ti.shownText(0, ti.length()), "•••••••••")
+	is.Equal(ti.possibleCursorPosXs[ti.length()], 9*fontutils.MeasureString("•", ti.font))
+	is.Equal(ti.Value(), "pass word")
+
+	// the masked value is a single word
+	ti.End()
+	ti.WordLeft()
+	is.Equal(ti.cursorPosition, textInputCursorPosition(0))
+
+	// the masked value can't be cut
+	ti.SelectAll()
+	ti.updateSelectionBounds()
+	ti.Cut()
+	is.Equal(ti.Value(), "pass word")
+
+	ti.SetValueShown(true)
+	is.Equal(ti.shownText(0, ti.length()), "pass word")
+
+	ti.ToggleValueShown()
+	is.True(!ti.ValueShown())
+	is.Equal(ti.shownText(0, ti.length()), "•••••••••")
+
+	ti.SetMasked(false)
+	is.Equal(ti.shownText(0, ti.length()), "pass word")
+}
+
+func TestTextInput_MaskChar(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestMaskedTextInput(in, TextInputOptions{MaskChar: '*'})
+	ti.SetValue("été") // a combining accent is masked with its letter
+
+	is.Equal(ti.shownText(0, ti.length()), "***")
+}
+
+func TestTextInput_MaskReveal(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestMaskedTextInput(in, TextInputOptions{MaskRevealDuration: time.Second})
+	ti.focused = true
+
+	typeChars(t, ti, in, "abc")
+	is.Equal(ti.shownText(0, ti.length()), "••c")
+	is.Equal(ti.possibleCursorPosXs[ti.length()], 2*fontutils.MeasureString("•", ti.font)+fontutils.MeasureString("c", ti.font))
+
+	wait(t, ti, time.Second)
+	handleState(t, ti)
+	is.Equal(ti.shownText(0, ti.length()), "•••")
+
+	// pasted text isn't revealed
+	ti.Insert([]rune("def"))
+	is.Equal(ti.shownText(0, ti.length()), "••••••")
+
+	typeChars(t, ti, in, "g")
+	ti.Backspace()
+	is.Equal(ti.shownText(0, ti.length()), "••••••") // editing masks the revealed character
+}
@@ -0,0 +1,145 @@
+package component
+
+import (
+	"image/color"
+
+	fontutils "github.com/fglo/chopstiqs/font"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// textInputContextMenuTextOffset is the distance between the context menu items' left edges and their labels.
+const textInputContextMenuTextOffset = 4
+
+// textInputContextMenuItems are the labels and the actions of the context menu's items, from the top.
+var textInputContextMenuItems = []struct {
+	label  string
+	action textInputAction
+}{
+	{"Cut", textInputCut},
+	{"Copy", textInputCopy},
+	{"Paste", textInputPaste},
+	{"Select All", textInputSelectAll},
+}
+
+// textInputContextMenu is the menu of the clipboard actions opened by right-clicking the text input.
+// It's created when it's opened for the first time.
+type textInputContextMenu struct {
+	list *Container
+	// items are the buttons of textInputContextMenuItems
+	items []*Button
+	open  bool
+	// pressed is set when a mouse button is pressed over the open menu. The text input loses the focus to the menu then,
+	// but keeps its selection for the item's action.
+	pressed bool
+}
+
+// IsContextMenuOpen returns whether the text input's context menu is open.
+func (ti *TextInput) IsContextMenuOpen() bool {
+	return ti.contextMenu.open
+}
+
+// OpenContextMenu opens the menu of the clipboard actions at the position on the screen.
+// The actions without the text to act on are disabled, and so are copying and cutting the masked value.
+func (ti *TextInput) OpenContextMenu(posX, posY int) {
+	if ti.disabled {
+		return
+	}
+
+	if ti.contextMenu.list == nil {
+		ti.setUpContextMenu()
+	}
+
+	ti.updateSelectionBounds()
+	for i, item := range textInputContextMenuItems {
+		switch item.action {
+		case textInputCut, textInputCopy:
+			ti.contextMenu.items[i].SetDisabled(!ti.HasSelectedText() || ti.mask.masked)
+		case textInputSelectAll:
+			ti.contextMenu.items[i].SetDisabled(ti.length() == 0)
+		}
+	}
+
+	ti.contextMenu.open = true
+	ti.showPopupAt(ti.contextMenu.list, posX, posY)
+}
+
+// CloseContextMenu closes the text input's context menu.
+func (ti *TextInput) CloseContextMenu() {
+	if !ti.contextMenu.open {
+		return
+	}
+
+	ti.contextMenu.open = false
+	ti.hidePopup(ti.contextMenu.list)
+
+	// the focus didn't come back from the menu, so the text input is unfocused now that it isn't needed
+	if ti.contextMenu.pressed && !ti.focused {
+		ti.unfocused()
+	}
+
+	ti.contextMenu.pressed = false
+}
+
+// setUpContextMenu creates the context menu's list of items.
+func (ti *TextInput) setUpContextMenu() {
+	const itemHeight = 15
+	textColor := color.RGBA{50, 50, 50, 255}
+
+	width := 0
+	for _, item := range textInputContextMenuItems {
+		width = max(width, fontutils.MeasureString(item.label, fontutils.DefaultFontFace)+2*textInputContextMenuTextOffset)
+	}
+
+	ti.contextMenu.list = NewContainer(&ContainerOptions{
+		Layout: &VerticalListLayout{},
+		Width:  option.Int(width),
+		Height: option.Int(len(textInputContextMenuItems) * itemHeight),
+	})
+	ti.contextMenu.list.SetBackgroundColor(color.RGBA{60, 60, 60, 255})
+
+	for _, item := range textInputContextMenuItems {
+		label := NewLabel(item.label, &LabelOptions{Color: textColor, Padding: &Padding{Left: textInputContextMenuTextOffset}})
+
+		button := NewButton(&ButtonOptions{Width: option.Int(width), Height: option.Int(itemHeight), Label: label})
+		button.SetTabIndex(-1)
+
+		label.horizontalAlignment = option.AlignmentLeft
+		label.align()
+
+		action := item.action
+		button.AddClickedHandler(func(args *ButtonClickedEventArgs) {
+			if !ti.contextMenu.open {
+				return
+			}
+
+			ti.contextMenu.pressed = false
+			ti.SetFocused(true)
+			ti.actionHandlers[action]()
+			ti.CloseContextMenu()
+		})
+
+		ti.contextMenu.list.AddComponent(button)
+		ti.contextMenu.items = append(ti.contextMenu.items, button)
+	}
+
+	ti.contextMenu.list.SetEventManager(ti.eventManager)
+}
+
+// fireContextMenuEvents closes the context menu when a mouse button is pressed outside of it.
+func (ti *TextInput) fireContextMenuEvents(in input.InputSource) {
+	if !ti.contextMenu.open {
+		return
+	}
+
+	if !in.MouseButtonJustPressed(ebiten.MouseButtonLeft) && !in.MouseButtonJustPressed(ebiten.MouseButtonRight) {
+		return
+	}
+
+	if ti.contextMenu.list.CursorOver() {
+		ti.contextMenu.pressed = true
+	} else {
+		ti.CloseContextMenu()
+	}
+}
@@ -0,0 +1,78 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+// contextMenuItem returns the context menu's item with the action.
+func contextMenuItem(ti *TextInput, action textInputAction) *Button {
+	for i, item := range textInputContextMenuItems {
+		if item.action == action {
+			return ti.contextMenu.items[i]
+		}
+	}
+
+	return nil
+}
+
+func TestTextInput_ContextMenu(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(&TextInputOptions{Width: option.Int(100)})
+	root := newTestMouseRoot(ti)
+	ti.SetValue("hello world")
+
+	_, y := ti.AbsPosition()
+
+	clickTextInput(t, root, in, positionX(ti, 3), int(y)+5, ebiten.MouseButtonRight)
+	is.True(ti.IsContextMenuOpen())
+	is.True(ti.Focused())
+	is.Equal(ti.cursorPosition, textInputCursorPosition(3)) // the cursor is moved to the click
+
+	is.True(contextMenuItem(ti, textInputCut).Disable())
+	is.True(contextMenuItem(ti, textInputCopy).Disable())
+	is.True(!contextMenuItem(ti, textInputPaste).Disable())
+	is.True(!contextMenuItem(ti, textInputSelectAll).Disable())
+
+	leftMouseButtonClick(t, &contextMenuItem(ti, textInputSelectAll).component)
+	is.True(!ti.IsContextMenuOpen())
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "hello world")
+
+	// right-clicking the selection keeps it
+	clickTextInput(t, root, in, positionX(ti, 3), int(y)+5, ebiten.MouseButtonRight)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "hello world")
+	is.True(!contextMenuItem(ti, textInputCut).Disable())
+	is.True(!contextMenuItem(ti, textInputCopy).Disable())
+
+	// pressing a mouse button outside of the menu closes it
+	clickTextInput(t, root, in, positionX(ti, 3), int(y)+5, ebiten.MouseButtonLeft)
+	is.True(!ti.IsContextMenuOpen())
+}
+
+func TestTextInput_ContextMenuDisabled(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(nil)
+	root := newTestMouseRoot(ti)
+	ti.SetValue("hello")
+
+	x, y := ti.AbsPosition()
+
+	clickTextInput(t, root, in, int(x)+5, int(y)+5, ebiten.MouseButtonRight)
+	is.True(ti.IsContextMenuOpen())
+
+	ti.SetDisabled(true)
+	mouseFrame(t, root, in, int(x)+5, int(y)+5)
+	is.True(!ti.IsContextMenuOpen()) // disabling the text input closes the menu
+
+	ti.OpenContextMenu(int(x)+5, int(y)+5)
+	is.True(!ti.IsContextMenuOpen())
+}
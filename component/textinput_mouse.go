@@ -0,0 +1,157 @@
+package component
+
+import (
+	"time"
+
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// textInputMultiClickInterval is the longest time between the presses of a double or a triple click.
+var textInputMultiClickInterval = 400 * time.Millisecond
+
+// textInputMultiClickDistance is the farthest, in pixels, the mouse cursor can move between the presses of a double or a triple click.
+const textInputMultiClickDistance = 4
+
+// textInputDragScrollInterval is the time between the scroll steps while the mouse is dragged past the text's edges.
+var textInputDragScrollInterval = 50 * time.Millisecond
+
+// press places the cursor where the left mouse button was pressed, or extends the selection to it if shift is held.
+// The second press in a row selects the word at the cursor and the third one selects the whole value.
+func (ti *TextInput) press(cursorPosX, cursorPosY int) {
+	ti.pressed = true
+	ti.countClick(cursorPosX, cursorPosY)
+
+	position := ti.positionAt(cursorPosX, cursorPosY)
+
+	switch {
+	case ti.clickCount == 2:
+		ti.dragStart, ti.dragEnd = ti.wordAt(position)
+	case ti.clickCount == 3:
+		ti.dragStart, ti.dragEnd = 0, ti.length()
+	case ti.modifierKeysPressed[ebiten.KeyShift]:
+		anchor := ti.selectingFrom
+		if anchor == -1 {
+			anchor = ti.cursorPosition
+		}
+
+		ti.dragStart, ti.dragEnd = anchor, anchor
+	default:
+		ti.dragStart, ti.dragEnd = position, position
+	}
+
+	ti.dragTo(position)
+}
+
+// countClick counts the press as the next click of a multi-click if it's close enough to the previous one in time and space.
+func (ti *TextInput) countClick(cursorPosX, cursorPosY int) {
+	scheduler := ti.eventManager.Scheduler()
+	now := scheduler.Now()
+
+	nearby := abs(cursorPosX-ti.lastPressX) <= textInputMultiClickDistance && abs(cursorPosY-ti.lastPressY) <= textInputMultiClickDistance
+
+	// without a scheduler, there's no clock to tell a multi-click from separate clicks
+	if nearby && scheduler != nil && now.Sub(ti.lastPressTime) < textInputMultiClickInterval {
+		ti.clickCount = ti.clickCount%3 + 1
+	} else {
+		ti.clickCount = 1
+	}
+
+	ti.lastPressX, ti.lastPressY = cursorPosX, cursorPosY
+	ti.lastPressTime = now
+}
+
+// drag extends the selection to the mouse cursor while the left mouse button is held,
+// scrolling the text if the cursor is past its edges.
+func (ti *TextInput) drag(cursorPosX, cursorPosY int) {
+	// without a scheduler, the text is scrolled every frame
+	scheduler := ti.eventManager.Scheduler()
+	if now := scheduler.Now(); scheduler == nil || now.Sub(ti.lastDragScrollTime) >= textInputDragScrollInterval {
+		ti.dragScroll(cursorPosX, cursorPosY)
+		ti.lastDragScrollTime = now
+	}
+
+	ti.dragTo(ti.positionAt(ti.clampToBounds(cursorPosX, cursorPosY)))
+
+	// the text is scrolled by the drag, not to the cursor
+	ti.mouseScrolled = true
+}
+
+// dragTo selects from what the press selected to the position. After a double click the selection is extended by whole words.
+func (ti *TextInput) dragTo(position textInputCursorPosition) {
+	start, end := position, position
+
+	switch ti.clickCount {
+	case 2:
+		start, end = ti.wordAt(position)
+	case 3:
+		start, end = 0, ti.length()
+	}
+
+	if start < ti.dragStart {
+		ti.selectRange(ti.dragEnd, start)
+	} else {
+		ti.selectRange(ti.dragStart, max(end, ti.dragEnd))
+	}
+}
+
+// selectRange selects the text between the positions, moving the cursor to the second one.
+func (ti *TextInput) selectRange(from, to textInputCursorPosition) {
+	ti.selecting = false
+	ti.moveCursor(to)
+	ti.selectingFrom = from
+}
+
+// wordAt returns the bounds of the word next to the position. If there's no word next to it, it returns the bounds of the separators around it.
+// Word separators don't span line breaks, and the masked value is a single word.
+func (ti *TextInput) wordAt(position textInputCursorPosition) (textInputCursorPosition, textInputCursorPosition) {
+	end := ti.length()
+	if ti.mask.masked {
+		return 0, end
+	}
+
+	inWord := position < end && !isWordSeparator(ti.grapheme(position)) || position > 0 && !isWordSeparator(ti.grapheme(position-1))
+
+	sameKind := func(p textInputCursorPosition) bool {
+		grapheme := ti.grapheme(p)
+		return !isLineBreak(grapheme) && isWordSeparator(grapheme) != inWord
+	}
+
+	start := position
+	for start > 0 && sameKind(start-1) {
+		start--
+	}
+
+	for position < end && sameKind(position) {
+		position++
+	}
+
+	return start, position
+}
+
+// clampToBounds returns the point inside of the text input closest to the mouse cursor's coordinates.
+func (ti *TextInput) clampToBounds(cursorPosX, cursorPosY int) (int, int) {
+	left := int(ti.absPosX) + ti.padding.Left
+	top := int(ti.absPosY) + ti.padding.Top
+
+	return min(max(cursorPosX, left), left+ti.width-1), min(max(cursorPosY, top), top+ti.height-1)
+}
+
+// dragScrollHorizontally scrolls the text a step towards the mouse cursor dragged past the text input's left or right edge.
+func (ti *TextInput) dragScrollHorizontally(cursorPosX, cursorPosY int) {
+	left := int(ti.absPosX) + ti.padding.Left
+
+	switch {
+	case cursorPosX < left:
+		ti.scrollHorizontally(1, 0)
+	case cursorPosX >= left+ti.width:
+		ti.scrollHorizontally(-1, 0)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,212 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+// newTestMouseRoot returns a root container with the components, whose scheduler runs on a fake clock.
+func newTestMouseRoot(components ...Component) *Container {
+	root := newTestRootContainer(components...)
+	root.SetEventManager(newTestEventManager())
+
+	return root
+}
+
+// mouseFrame moves the mouse cursor and runs a frame of the root container's events.
+func mouseFrame(t *testing.T, root *Container, in *input.FakeInputSource, x, y int) {
+	t.Helper()
+
+	in.MoveCursor(x, y)
+	root.eventManager.Scheduler().Tick()
+	fireRootEvents(t, root, in)
+}
+
+// clickTextInput clicks the text input at the mouse cursor's coordinates with the mouse button.
+func clickTextInput(t *testing.T, root *Container, in *input.FakeInputSource, x, y int, button ebiten.MouseButton) {
+	t.Helper()
+
+	in.PressMouseButton(button)
+	mouseFrame(t, root, in, x, y)
+	in.ReleaseMouseButton(button)
+	mouseFrame(t, root, in, x, y)
+}
+
+// positionX returns the mouse cursor's X coordinate of the text input's cursor position.
+func positionX(ti *TextInput, position textInputCursorPosition) int {
+	return int(ti.absPosX) + ti.padding.Left + ti.textPosX + ti.possibleCursorPosXs[position] - ti.scrollOffset - 1
+}
+
+func TestTextInput_MouseDrag(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(&TextInputOptions{Width: option.Int(100)})
+	root := newTestMouseRoot(ti)
+	ti.SetValue("hello world")
+
+	_, y := ti.AbsPosition()
+
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, positionX(ti, 2), int(y)+5)
+	mouseFrame(t, root, in, positionX(ti, 5), int(y)+5)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "llo")
+
+	mouseFrame(t, root, in, positionX(ti, 0), int(y)+5)
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, positionX(ti, 0), int(y)+5)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "he") // dragged back before the press
+	is.Equal(ti.cursorPosition, textInputCursorPosition(0))
+}
+
+func TestTextInput_MouseDragAutoScroll(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(&TextInputOptions{Width: option.Int(40)})
+	root := newTestMouseRoot(ti)
+	ti.SetValue("a text that is much longer than the input")
+
+	x, y := ti.AbsPosition()
+	clock := root.eventManager.Scheduler().Clock().(*timer.FakeClock)
+
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, int(x)+1, int(y)+5)
+
+	// the text scrolls a step per interval while the mouse is dragged past the right edge
+	mouseFrame(t, root, in, int(x)+60, int(y)+5)
+	is.Equal(ti.scrollOffset, textInputWheelScrollSpeed)
+
+	mouseFrame(t, root, in, int(x)+60, int(y)+5)
+	is.Equal(ti.scrollOffset, textInputWheelScrollSpeed)
+
+	clock.Advance(textInputDragScrollInterval)
+	mouseFrame(t, root, in, int(x)+60, int(y)+5)
+	is.Equal(ti.scrollOffset, 2*textInputWheelScrollSpeed)
+
+	// the selection reaches the right edge
+	is.Equal(ti.selectingFrom, textInputCursorPosition(0))
+	is.Equal(ti.cursorPosition, ti.findClosestPossibleCursorPosition(int(x)+ti.width-1))
+
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, int(x)+60, int(y)+5)
+	ti.Draw()
+	is.Equal(ti.scrollOffset, 2*textInputWheelScrollSpeed) // the text stays where it was scrolled to
+}
+
+func TestTextInput_ShiftClick(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(&TextInputOptions{Width: option.Int(100)})
+	root := newTestMouseRoot(ti)
+	ti.SetValue("hello world")
+
+	_, y := ti.AbsPosition()
+	clock := root.eventManager.Scheduler().Clock().(*timer.FakeClock)
+
+	clickTextInput(t, root, in, positionX(ti, 3), int(y)+5, ebiten.MouseButtonLeft)
+	is.Equal(ti.cursorPosition, textInputCursorPosition(3))
+
+	clock.Advance(textInputMultiClickInterval)
+	in.PressKey(ebiten.KeyShift)
+	clickTextInput(t, root, in, positionX(ti, 8), int(y)+5, ebiten.MouseButtonLeft)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "lo wo")
+
+	clock.Advance(textInputMultiClickInterval)
+	clickTextInput(t, root, in, positionX(ti, 1), int(y)+5, ebiten.MouseButtonLeft)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "el") // the selection is extended from where it started
+	in.ReleaseKey(ebiten.KeyShift)
+
+	clock.Advance(textInputMultiClickInterval)
+	clickTextInput(t, root, in, positionX(ti, 5), int(y)+5, ebiten.MouseButtonLeft)
+	ti.updateSelectionBounds()
+	is.True(!ti.HasSelectedText())
+	is.Equal(ti.cursorPosition, textInputCursorPosition(5))
+}
+
+func TestTextInput_MultiClick(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(&TextInputOptions{Width: option.Int(100)})
+	root := newTestMouseRoot(ti)
+	ti.SetValue("hello big world")
+
+	_, y := ti.AbsPosition()
+	clock := root.eventManager.Scheduler().Clock().(*timer.FakeClock)
+
+	clickTextInput(t, root, in, positionX(ti, 7), int(y)+5, ebiten.MouseButtonLeft)
+	clickTextInput(t, root, in, positionX(ti, 7), int(y)+5, ebiten.MouseButtonLeft)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "big")
+
+	clickTextInput(t, root, in, positionX(ti, 7), int(y)+5, ebiten.MouseButtonLeft)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "hello big world")
+
+	// a click after the interval starts over
+	clock.Advance(textInputMultiClickInterval)
+	clickTextInput(t, root, in, positionX(ti, 7), int(y)+5, ebiten.MouseButtonLeft)
+	ti.updateSelectionBounds()
+	is.True(!ti.HasSelectedText())
+
+	// so does a click far from the previous one
+	clickTextInput(t, root, in, positionX(ti, 13), int(y)+5, ebiten.MouseButtonLeft)
+	ti.updateSelectionBounds()
+	is.True(!ti.HasSelectedText())
+
+	// dragging after a double click selects whole words
+	clock.Advance(textInputMultiClickInterval)
+	clickTextInput(t, root, in, positionX(ti, 7), int(y)+5, ebiten.MouseButtonLeft)
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, positionX(ti, 7), int(y)+5)
+	mouseFrame(t, root, in, positionX(ti, 12), int(y)+5)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "big world")
+
+	mouseFrame(t, root, in, positionX(ti, 2), int(y)+5)
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "hello big")
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	mouseFrame(t, root, in, positionX(ti, 2), int(y)+5)
+}
+
+func TestTextInput_wordAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		position textInputCursorPosition
+		start    textInputCursorPosition
+		end      textInputCursorPosition
+	}{
+		{name: "inside of a word", value: "hello big world", position: 7, start: 6, end: 9},
+		{name: "at the word's start", value: "hello big world", position: 6, start: 6, end: 9},
+		{name: "at the word's end", value: "hello big world", position: 9, start: 6, end: 9},
+		{name: "between separators", value: "a  ,  b", position: 3, start: 1, end: 6},
+		{name: "separators end at a line break", value: "a  \n  b", position: 2, start: 1, end: 3},
+		{name: "empty value", value: "", position: 0, start: 0, end: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			ti := NewTextInput(nil)
+			ti.SetValue(tt.value)
+
+			start, end := ti.wordAt(tt.position)
+			is.Equal(start, tt.start)
+			is.Equal(end, tt.end)
+		})
+	}
+}
@@ -1,281 +1,317 @@
 package component
 
 import (
+	"image/color"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/fglo/chopstiqs/event"
 	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
-func newTestTextInput() *TextInput {
-	eventManager := event.NewManager()
-
+func newTestTextInput(in input.InputSource) *TextInput {
 	ti := NewTextInput(nil)
-	ti.SetEventManager(eventManager)
+	ti.SetEventManager(newTestEventManager())
+	ti.inputSource = in
 
 	return ti
 }
 
+// handleState runs a frame of the text input's state machine.
 func handleState(t *testing.T, ti *TextInput) {
 	t.Helper()
 
+	ti.eventManager.Scheduler().Tick()
 	ti.state = ti.state(ti)
 	ti.eventManager.HandleFired()
 }
 
-func resetInput(t *testing.T) {
+// wait advances the text input's fake clock.
+func wait(t *testing.T, ti *TextInput, d time.Duration) {
 	t.Helper()
 
-	input.DetectSystem()
-	input.InputChars = ebiten.AppendInputChars(input.InputChars)
-	input.AnyKeyPressed = false
-	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
-		input.KeyPressed[k] = false
-	}
+	ti.eventManager.Scheduler().Clock().(*timer.FakeClock).Advance(d)
 }
 
 func TestTextInput_PressedLeft(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("qwerty")
 
 	ti.focused = true
 	ti.cursorPosition = 2
 
-	keyPress(t, ebiten.KeyLeft)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyLeft)
+	wait(t, ti, textInputActionRepeatDelay)
+	handleState(t, ti)
 	handleState(t, ti)
+	is.Equal(int(ti.cursorPosition), 1)
+
+	keyRelease(t, in, ebiten.KeyLeft)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 1)
 
-	keyRelease(t, ebiten.KeyLeft)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyLeft)
+	wait(t, ti, textInputActionRepeatDelay)
+	handleState(t, ti)
 	handleState(t, ti)
+	is.Equal(int(ti.cursorPosition), 0)
+
+	keyRelease(t, in, ebiten.KeyLeft)
+}
+
+func TestTextInput_PressedKeysWithoutScheduler(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(nil)
+	ti.SetEventManager(event.NewManager())
+	ti.inputSource = in
+	ti.SetValue("qwerty")
+
+	ti.focused = true
+	ti.cursorPosition = 2
+
+	keyPress(t, in, ebiten.KeyLeft)
+	for i := 0; i < 10; i++ {
+		handleState(t, ti)
+	}
+	// without a clock, a held key doesn't repeat
 	is.Equal(int(ti.cursorPosition), 1)
+	keyRelease(t, in, ebiten.KeyLeft)
+	handleState(t, ti)
 
-	keyPress(t, ebiten.KeyLeft)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyLeft)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 0)
 
-	keyRelease(t, ebiten.KeyLeft)
+	// the next action isn't delayed
+	keyRelease(t, in, ebiten.KeyLeft)
+	keyPress(t, in, ebiten.KeyRight)
+	handleState(t, ti)
+	handleState(t, ti)
+	is.Equal(int(ti.cursorPosition), 1)
+	keyRelease(t, in, ebiten.KeyRight)
 }
 
 func TestTextInput_PressedLeftWithControl_onWindows(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	input.OS = input.Windows
+	in.SetOS(input.Windows)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("01234 6789")
 
 	ti.focused = true
 	ti.cursorPosition = 9
 
-	keyPress(t, ebiten.KeyLeft)
-	keyPress(t, ebiten.KeyControl)
+	keyPress(t, in, ebiten.KeyLeft)
+	keyPress(t, in, ebiten.KeyControl)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 6)
 
-	time.Sleep(time.Millisecond)
+	wait(t, ti, time.Millisecond)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 6)
 
-	time.Sleep(textInputActionRepeatDelay)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 0)
 
-	keyRelease(t, ebiten.KeyLeft)
-	keyRelease(t, ebiten.KeyControl)
+	keyRelease(t, in, ebiten.KeyLeft)
+	keyRelease(t, in, ebiten.KeyControl)
 }
 
 func TestTextInput_PressedLeftWithAlt_onMacOS(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	input.OS = input.MacOS
+	in.SetOS(input.MacOS)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("01234 6789")
 
 	ti.focused = true
 	ti.cursorPosition = 9
 
-	keyPress(t, ebiten.KeyLeft)
-	keyPress(t, ebiten.KeyAlt)
+	keyPress(t, in, ebiten.KeyLeft)
+	keyPress(t, in, ebiten.KeyAlt)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 6)
 
-	time.Sleep(time.Millisecond)
+	wait(t, ti, time.Millisecond)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 6)
 
-	time.Sleep(textInputActionRepeatDelay)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 0)
 
-	keyRelease(t, ebiten.KeyLeft)
-	keyRelease(t, ebiten.KeyAlt)
+	keyRelease(t, in, ebiten.KeyLeft)
+	keyRelease(t, in, ebiten.KeyAlt)
 }
 
 func TestTextInput_PressedLeftWithShift(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("qwerty")
 
 	ti.focused = true
 	ti.cursorPosition = 2
 
-	keyPress(t, ebiten.KeyLeft)
-	keyPress(t, ebiten.KeyShift)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyLeft)
+	keyPress(t, in, ebiten.KeyShift)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.selectingFrom), 2)
 	is.Equal(int(ti.cursorPosition), 1)
 
-	keyRelease(t, ebiten.KeyLeft)
-	keyRelease(t, ebiten.KeyShift)
+	keyRelease(t, in, ebiten.KeyLeft)
+	keyRelease(t, in, ebiten.KeyShift)
 }
 
 func TestTextInput_PressedRight(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("qwerty")
 
 	ti.focused = true
 	ti.cursorPosition = 0
 
-	keyPress(t, ebiten.KeyRight)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyRight)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 1)
 
-	keyRelease(t, ebiten.KeyRight)
-	time.Sleep(textInputActionRepeatDelay)
+	keyRelease(t, in, ebiten.KeyRight)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 1)
 
-	keyPress(t, ebiten.KeyRight)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyRight)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 2)
 
-	keyRelease(t, ebiten.KeyRight)
+	keyRelease(t, in, ebiten.KeyRight)
 }
 
 func TestTextInput_PressedRightWithControl_onWindows(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	input.OS = input.Windows
+	in.SetOS(input.Windows)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("01234 6789")
 
 	ti.focused = true
 	ti.cursorPosition = 1
 
-	keyPress(t, ebiten.KeyRight)
-	keyPress(t, ebiten.KeyControl)
+	keyPress(t, in, ebiten.KeyRight)
+	keyPress(t, in, ebiten.KeyControl)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 5)
 
-	time.Sleep(time.Millisecond)
+	wait(t, ti, time.Millisecond)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 5)
 
-	time.Sleep(textInputActionRepeatDelay)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 10)
 
-	keyRelease(t, ebiten.KeyRight)
-	keyRelease(t, ebiten.KeyControl)
+	keyRelease(t, in, ebiten.KeyRight)
+	keyRelease(t, in, ebiten.KeyControl)
 }
 
 func TestTextInput_PressedRightWithAlt_onMacOS(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	input.OS = input.MacOS
+	in.SetOS(input.MacOS)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("01234 6789")
 
 	ti.focused = true
 	ti.cursorPosition = 1
 
-	keyPress(t, ebiten.KeyRight)
-	keyPress(t, ebiten.KeyAlt)
+	keyPress(t, in, ebiten.KeyRight)
+	keyPress(t, in, ebiten.KeyAlt)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 5)
 
-	time.Sleep(time.Millisecond)
+	wait(t, ti, time.Millisecond)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 5)
 
-	time.Sleep(textInputActionRepeatDelay)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.cursorPosition), 10)
 
-	keyRelease(t, ebiten.KeyRight)
-	keyRelease(t, ebiten.KeyAlt)
+	keyRelease(t, in, ebiten.KeyRight)
+	keyRelease(t, in, ebiten.KeyAlt)
 }
 
 func TestTextInput_PressedRightWithShift(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("qwerty")
 
 	ti.focused = true
 	ti.cursorPosition = 0
 
-	keyPress(t, ebiten.KeyRight)
-	keyPress(t, ebiten.KeyShift)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyRight)
+	keyPress(t, in, ebiten.KeyShift)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(int(ti.selectingFrom), 0)
 	is.Equal(int(ti.cursorPosition), 1)
 
-	keyRelease(t, ebiten.KeyRight)
-	keyRelease(t, ebiten.KeyShift)
+	keyRelease(t, in, ebiten.KeyRight)
+	keyRelease(t, in, ebiten.KeyShift)
 }
 
 func TestTextInput_PressedEnter(t *testing.T) {
 	is := is.New(t)
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	firedEventsCounter := 0
 
-	ti := newTestTextInput()
+	ti := newTestTextInput(in)
 	ti.SetValue("qwerty")
 	ti.AddSubmittedHandler(func(args *TextInputSubmittedEventArgs) {
 		firedEventsCounter++
@@ -283,28 +319,28 @@ func TestTextInput_PressedEnter(t *testing.T) {
 
 	ti.focused = true
 
-	keyPress(t, ebiten.KeyEnter)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyEnter)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(firedEventsCounter, 1)
 
-	keyRelease(t, ebiten.KeyEnter)
-	time.Sleep(textInputActionRepeatDelay)
+	keyRelease(t, in, ebiten.KeyEnter)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	is.Equal(firedEventsCounter, 1)
 
-	keyPress(t, ebiten.KeyEnter)
-	time.Sleep(textInputActionRepeatDelay)
+	keyPress(t, in, ebiten.KeyEnter)
+	wait(t, ti, textInputActionRepeatDelay)
 	handleState(t, ti)
 	handleState(t, ti)
 	is.Equal(firedEventsCounter, 2)
 
-	keyRelease(t, ebiten.KeyEnter)
+	keyRelease(t, in, ebiten.KeyEnter)
 }
 
 func TestTextInput_handleKeyLeft(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -319,56 +355,56 @@ func TestTextInput_handleKeyLeft(t *testing.T) {
 		{
 			name:                "Left with ctrl (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyControl},
-			before:              func() { input.OS = input.Windows },
+			before:              func() { in.SetOS(input.Windows) },
 			want:                textInputWordLeft,
 		},
 		{
 			name:                "Left with meta (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyMeta},
-			before:              func() { input.OS = input.Windows },
+			before:              func() { in.SetOS(input.Windows) },
 			want:                textInputIdle,
 		},
 		{
 			name:                "Left with meta (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyMeta},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputHome,
 		},
 		{
 			name:                "Left with ctrl (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyControl},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputCursorLeft,
 		},
 		{
 			name:                "Left + Alt (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputWordLeft,
 		},
 		{
 			name:                "Left + Alt + Control (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt, ebiten.KeyControl},
-			before:              func() { input.OS = input.Windows },
+			before:              func() { in.SetOS(input.Windows) },
 			want:                textInputIdle,
 		},
 		{
 			name:                "Left + Alt + Meta (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt, ebiten.KeyMeta},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputIdle,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			for _, key := range tt.pressedModifierKeys {
 				ti.modifierKeysPressed[key] = true
@@ -384,7 +420,7 @@ func TestTextInput_handleKeyLeft(t *testing.T) {
 }
 
 func TestTextInput_handleKeyRight(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -399,56 +435,56 @@ func TestTextInput_handleKeyRight(t *testing.T) {
 		{
 			name:                "Right with ctrl (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyControl},
-			before:              func() { input.OS = input.Windows },
+			before:              func() { in.SetOS(input.Windows) },
 			want:                textInputWordRight,
 		},
 		{
 			name:                "Right with meta (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyMeta},
-			before:              func() { input.OS = input.Windows },
+			before:              func() { in.SetOS(input.Windows) },
 			want:                textInputIdle,
 		},
 		{
 			name:                "Right with meta (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyMeta},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputEnd,
 		},
 		{
 			name:                "Right with ctrl (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyControl},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputCursorRight,
 		},
 		{
 			name:                "Right + Alt (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputWordRight,
 		},
 		{
 			name:                "Right + Alt + Control (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt, ebiten.KeyControl},
-			before:              func() { input.OS = input.Windows },
+			before:              func() { in.SetOS(input.Windows) },
 			want:                textInputIdle,
 		},
 		{
 			name:                "Right + Alt + Meta (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt, ebiten.KeyMeta},
-			before:              func() { input.OS = input.MacOS },
+			before:              func() { in.SetOS(input.MacOS) },
 			want:                textInputIdle,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			for _, key := range tt.pressedModifierKeys {
 				ti.modifierKeysPressed[key] = true
@@ -464,7 +500,7 @@ func TestTextInput_handleKeyRight(t *testing.T) {
 }
 
 func TestTextInput_handleKeyHome(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -480,13 +516,13 @@ func TestTextInput_handleKeyHome(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			for _, key := range tt.pressedModifierKeys {
 				ti.modifierKeysPressed[key] = true
@@ -502,7 +538,7 @@ func TestTextInput_handleKeyHome(t *testing.T) {
 }
 
 func TestTextInput_handleKeyEnd(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -518,13 +554,13 @@ func TestTextInput_handleKeyEnd(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			for _, key := range tt.pressedModifierKeys {
 				ti.modifierKeysPressed[key] = true
@@ -540,7 +576,7 @@ func TestTextInput_handleKeyEnd(t *testing.T) {
 }
 
 func TestTextInput_handleKeyDelete(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -555,13 +591,13 @@ func TestTextInput_handleKeyDelete(t *testing.T) {
 		{
 			name:                "Delete + Alt (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt},
-			before:              func(*TextInput) { input.OS = input.MacOS },
+			before:              func(*TextInput) { in.SetOS(input.MacOS) },
 			want:                textInputDeleteWord,
 		},
 		{
 			name:                "Delete + CTRL (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyControl},
-			before:              func(*TextInput) { input.OS = input.Windows },
+			before:              func(*TextInput) { in.SetOS(input.Windows) },
 			want:                textInputDeleteWord,
 		},
 		{
@@ -576,9 +612,9 @@ func TestTextInput_handleKeyDelete(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			if tt.before != nil {
 				tt.before(ti)
@@ -598,7 +634,7 @@ func TestTextInput_handleKeyDelete(t *testing.T) {
 }
 
 func TestTextInput_handleKeyBackspace(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -613,13 +649,13 @@ func TestTextInput_handleKeyBackspace(t *testing.T) {
 		{
 			name:                "Backspace + Alt (MacOS)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyAlt},
-			before:              func(*TextInput) { input.OS = input.MacOS },
+			before:              func(*TextInput) { in.SetOS(input.MacOS) },
 			want:                textInputBackspaceWord,
 		},
 		{
 			name:                "Backspace + CTRL (Windows)",
 			pressedModifierKeys: []ebiten.Key{ebiten.KeyControl},
-			before:              func(*TextInput) { input.OS = input.Windows },
+			before:              func(*TextInput) { in.SetOS(input.Windows) },
 			want:                textInputBackspaceWord,
 		},
 		{
@@ -634,9 +670,9 @@ func TestTextInput_handleKeyBackspace(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			if tt.before != nil {
 				tt.before(ti)
@@ -656,7 +692,7 @@ func TestTextInput_handleKeyBackspace(t *testing.T) {
 }
 
 func TestTextInput_handleKeyEnter(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -687,13 +723,13 @@ func TestTextInput_handleKeyEnter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			for _, key := range tt.pressedModifierKeys {
 				ti.modifierKeysPressed[key] = true
@@ -709,7 +745,7 @@ func TestTextInput_handleKeyEnter(t *testing.T) {
 }
 
 func TestTextInput_handleKeyEscape(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                string
@@ -740,13 +776,13 @@ func TestTextInput_handleKeyEscape(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			for _, key := range tt.pressedModifierKeys {
 				ti.modifierKeysPressed[key] = true
@@ -762,7 +798,7 @@ func TestTextInput_handleKeyEscape(t *testing.T) {
 }
 
 func TestTextInput_handleKeyCtrl_Windows(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                  string
@@ -829,22 +865,24 @@ func TestTextInput_handleKeyCtrl_Windows(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
-			input.OS = input.Windows
+			in.SetOS(input.Windows)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			ti.modifierKeysPressed[ebiten.KeyControl] = true
 
 			for _, key := range tt.pressedAdditionalKeys {
-				input.KeyPressed[key] = true
+				in.PressKey(key)
 			}
 
+			in.Update()
+
 			got := ti.handleKeyCtrl()
 
 			if got != tt.want {
@@ -855,7 +893,7 @@ func TestTextInput_handleKeyCtrl_Windows(t *testing.T) {
 }
 
 func TestTextInput_handleKeyMeta_MacOS(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name                  string
@@ -922,22 +960,24 @@ func TestTextInput_handleKeyMeta_MacOS(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
-			input.OS = input.MacOS
+			in.SetOS(input.MacOS)
 
 			if tt.before != nil {
 				tt.before()
 			}
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			ti.modifierKeysPressed[ebiten.KeyMeta] = true
 
 			for _, key := range tt.pressedAdditionalKeys {
-				input.KeyPressed[key] = true
+				in.PressKey(key)
 			}
 
+			in.Update()
+
 			got := ti.handleKeyMeta()
 
 			if got != tt.want {
@@ -948,7 +988,7 @@ func TestTextInput_handleKeyMeta_MacOS(t *testing.T) {
 }
 
 func TestTextInput_keyCombinations(t *testing.T) {
-	resetInput(t)
+	in := newTestInputSource(t)
 
 	tests := []struct {
 		name        string
@@ -964,43 +1004,43 @@ func TestTextInput_keyCombinations(t *testing.T) {
 		{
 			name:        "Left + CTRL (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyLeft, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputWordLeft,
 		},
 		{
 			name:        "Left + meta (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyLeft, ebiten.KeyMeta},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "Left + meta (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyLeft, ebiten.KeyMeta},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputHome,
 		},
 		{
 			name:        "Left + CTRL (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyLeft, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "Left + Alt (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyLeft, ebiten.KeyAlt},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputWordLeft,
 		},
 		{
 			name:        "Left + Alt + Control (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyLeft, ebiten.KeyAlt, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "Left + Alt + Meta (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyLeft, ebiten.KeyAlt, ebiten.KeyMeta},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputIdle,
 		},
 		{
@@ -1011,43 +1051,43 @@ func TestTextInput_keyCombinations(t *testing.T) {
 		{
 			name:        "Right + CTRL (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyRight, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputWordRight,
 		},
 		{
 			name:        "Right + meta (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyRight, ebiten.KeyMeta},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "Right + meta (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyRight, ebiten.KeyMeta},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputEnd,
 		},
 		{
 			name:        "Right + CTRL (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyRight, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "Right + Alt (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyRight, ebiten.KeyAlt},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputWordRight,
 		},
 		{
 			name:        "Right + Alt + Control (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyRight, ebiten.KeyAlt, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "Right + Alt + Meta (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyRight, ebiten.KeyAlt, ebiten.KeyMeta},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputIdle,
 		},
 		{
@@ -1068,25 +1108,25 @@ func TestTextInput_keyCombinations(t *testing.T) {
 		{
 			name:        "Delete + Shift (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyDelete, ebiten.KeyShift},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputRemoveLine,
 		},
 		{
 			name:        "Delete + Shift (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyDelete, ebiten.KeyShift},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "Delete + CTRL (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyDelete, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputDeleteWord,
 		},
 		{
 			name:        "Delete + Alt (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyDelete, ebiten.KeyAlt},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputDeleteWord,
 		},
 		{
@@ -1111,13 +1151,13 @@ func TestTextInput_keyCombinations(t *testing.T) {
 		{
 			name:        "Backspace + CTRL (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyBackspace, ebiten.KeyControl},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputBackspaceWord,
 		},
 		{
 			name:        "Backspace + Alt (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyBackspace, ebiten.KeyAlt},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputBackspaceWord,
 		},
 		{
@@ -1172,151 +1212,153 @@ func TestTextInput_keyCombinations(t *testing.T) {
 		{
 			name:        "CTRL + Left (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyLeft},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputWordLeft,
 		},
 		{
 			name:        "CTRL + Right (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyRight},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputWordRight,
 		},
 		{
 			name:        "CTRL + C (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyC},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputCopy,
 		},
 		{
 			name:        "CTRL + V (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyV},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputPaste,
 		},
 		{
 			name:        "CTRL + X (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyX},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputCut,
 		},
 		{
 			name:        "CTRL + Z (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyZ},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputUndo,
 		},
 		{
 			name:        "CTRL + Y (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyY},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputRedo,
 		},
 		{
 			name:        "CTRL + SHIFT + Z (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyShift, ebiten.KeyZ},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputRedo,
 		},
 		{
 			name:        "CTRL + A (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyA},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputSelectAll,
 		},
 		{
 			name:        "CTRL + Backspace (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyBackspace},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputBackspaceWord,
 		},
 		{
 			name:        "CTRL + Delete (Windows)",
 			pressedKeys: []ebiten.Key{ebiten.KeyControl, ebiten.KeyDelete},
-			before:      func(ti *TextInput) { input.OS = input.Windows },
+			before:      func(ti *TextInput) { in.SetOS(input.Windows) },
 			want:        textInputDeleteWord,
 		},
 		{
 			name:        "CMD + Left (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyLeft},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputHome,
 		},
 		{
 			name:        "CMD + Right (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyRight},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputEnd,
 		},
 		{
 			name:        "CMD + C (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyC},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputCopy,
 		},
 		{
 			name:        "CMD + V (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyV},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputPaste,
 		},
 		{
 			name:        "CMD + X (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyX},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputCut,
 		},
 		{
 			name:        "CMD + Z (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyZ},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputUndo,
 		},
 		{
 			name:        "CMD + Y (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyY},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputIdle,
 		},
 		{
 			name:        "CMD + SHIFT + Z (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyShift, ebiten.KeyZ},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputRedo,
 		},
 		{
 			name:        "CMD + A (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyA},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputSelectAll,
 		},
 		{
 			name:        "CMD + Backspace (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyBackspace},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputBackspaceToBeginning,
 		},
 		{
 			name:        "CMD + Delete (MacOS)",
 			pressedKeys: []ebiten.Key{ebiten.KeyMeta, ebiten.KeyDelete},
-			before:      func(ti *TextInput) { input.OS = input.MacOS },
+			before:      func(ti *TextInput) { in.SetOS(input.MacOS) },
 			want:        textInputDeleteToEnd,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resetInput(t)
+			in = newTestInputSource(t)
 
-			ti := NewTextInput(nil)
+			ti := newTestTextInput(in)
 
 			if tt.before != nil {
 				tt.before(ti)
 			}
 
 			for _, key := range tt.pressedKeys {
-				input.KeyPressed[key] = true
+				in.PressKey(key)
 			}
 
+			in.Update()
+
 			if pressed, key := ti.actionKeyPressed(); pressed {
 				got := ti.handleActionKey(key)
 
@@ -1330,6 +1372,43 @@ func TestTextInput_keyCombinations(t *testing.T) {
 	}
 }
 
+func TestTextInput_SelectAll(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.SetValue("qwerty")
+
+	ti.focused = true
+	ti.cursorPosition = 2
+
+	ti.SelectAll()
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "qwerty")
+}
+
+func TestTextInput_BackspaceWord(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.SetValue("qwerty asdf zxcv")
+
+	ti.focused = true
+	ti.cursorPosition = 11
+
+	ti.BackspaceWord()
+	is.Equal(ti.value, "qwerty  zxcv")
+	is.Equal(ti.cursorPosition, textInputCursorPosition(7)) // the cursor stays where the word was
+}
+
+func TestTextInput_ColorHovered(t *testing.T) {
+	is := is.New(t)
+
+	ti := NewTextInput(&TextInputOptions{ColorHovered: color.RGBA{255, 0, 0, 255}})
+	is.Equal(ti.colorHovered, color.RGBA{255, 0, 0, 255})
+}
+
 func TestTextInput_Insert(t *testing.T) {
 	type args struct {
 		chars []rune
@@ -1354,7 +1433,7 @@ func TestTextInput_Insert(t *testing.T) {
 				chars: []rune{'q', 'w', 'e'},
 			},
 			before: func(ti *TextInput) {
-				ti.value = "rty"
+				ti.setValue("rty")
 				ti.cursorPosition = 0
 			},
 			want: "qwerty",
@@ -1365,7 +1444,7 @@ func TestTextInput_Insert(t *testing.T) {
 				chars: []rune{'y', 'u', 'i'},
 			},
 			before: func(ti *TextInput) {
-				ti.value = "qwertop"
+				ti.setValue("qwertop")
 				ti.cursorPosition = 5
 			},
 			want: "qwertyuiop",
@@ -1376,7 +1455,7 @@ func TestTextInput_Insert(t *testing.T) {
 				chars: []rune{'r', 't', 'y'},
 			},
 			before: func(ti *TextInput) {
-				ti.value = "qwe"
+				ti.setValue("qwe")
 				ti.cursorPosition = 3
 			},
 			want: "qwerty",
@@ -1387,7 +1466,7 @@ func TestTextInput_Insert(t *testing.T) {
 				chars: []rune{'a', 's', 'd', 'f'},
 			},
 			before: func(ti *TextInput) {
-				ti.value = "qwerty"
+				ti.setValue("qwerty")
 				ti.selectingFrom = 0
 				ti.cursorPosition = 6
 				ti.updateSelectionBounds()
@@ -1399,9 +1478,9 @@ func TestTextInput_Insert(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
-			resetInput(t)
+			in := newTestInputSource(t)
 
-			ti := newTestTextInput()
+			ti := newTestTextInput(in)
 
 			if tt.before != nil {
 				tt.before(ti)
@@ -1415,3 +1494,133 @@ func TestTextInput_Insert(t *testing.T) {
 		})
 	}
 }
+
+func TestTextInput_MouseWheel(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := NewTextInput(&TextInputOptions{Width: option.Int(40)})
+	root := newTestRootContainer(ti)
+	ti.SetValue("a text that is much longer than the input")
+
+	x, y := ti.AbsPosition()
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, -1, 0)
+	is.Equal(ti.scrollOffset, textInputWheelScrollSpeed)
+
+	ti.Draw()
+	is.Equal(ti.scrollOffset, textInputWheelScrollSpeed) // the offset doesn't follow the cursor
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, 5)
+	is.Equal(ti.scrollOffset, 0) // bounded at the start of the text
+
+	scrollWheel(t, root, in, int(x)+1, int(y)+1, 0, -100)
+	is.Equal(ti.scrollOffset, ti.boundScrollOffset(math.MaxInt)) // bounded at the end of the text
+
+	ti.focused = true
+	ti.End()
+	ti.Home()
+	ti.Draw()
+	is.Equal(ti.scrollOffset, 0) // follows the cursor again after it moved
+}
+
+func TestTextInput_CursorBlink(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.SetValue("qwerty")
+	scheduler := ti.eventManager.Scheduler()
+
+	ti.SetFocused(true)
+	ti.eventManager.HandleFired()
+	is.True(ti.cursor.visible)
+
+	tick := func(frames int) {
+		for i := 0; i < frames; i++ {
+			scheduler.Tick()
+		}
+	}
+
+	tick(textInputCursorVisibleFrames)
+	is.True(!ti.cursor.visible)
+
+	tick(textInputCursorHiddenFrames)
+	is.True(ti.cursor.visible)
+
+	tick(textInputCursorVisibleFrames)
+	is.True(!ti.cursor.visible)
+
+	ti.moveCursor(1)
+	is.True(ti.cursor.visible) // moving the cursor shows it
+
+	tick(textInputCursorVisibleFrames - 1)
+	is.True(ti.cursor.visible)
+
+	ti.SetFocused(false)
+	ti.eventManager.HandleFired()
+	tick(textInputCursorVisibleFrames)
+	is.True(ti.cursor.visible) // stops blinking when unfocused
+	is.True(!ti.cursor.blinkTimer.Active())
+}
+
+func TestTextInput_Unicode(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.focused = true
+
+	typeChars(t, ti, in, "zażółć")
+	is.Equal(ti.Value(), "zażółć")
+	is.Equal(ti.cursorPosition, textInputCursorPosition(6))
+
+	ti.Backspace()
+	is.Equal(ti.Value(), "zażół")
+	is.Equal(ti.cursorPosition, textInputCursorPosition(5))
+
+	ti.CursorLeft()
+	ti.CursorLeft()
+	ti.Insert([]rune("👍🏽"))
+	is.Equal(ti.Value(), "zaż👍🏽ół")
+	is.Equal(ti.cursorPosition, textInputCursorPosition(4)) // the emoji with its modifier is a single character
+
+	ti.CursorLeft()
+	ti.Delete()
+	is.Equal(ti.Value(), "zażół")
+
+	ti.Insert([]rune("e"))
+	ti.Insert([]rune("\u0301"))
+	is.Equal(ti.Value(), "zaże\u0301ół")
+	is.Equal(ti.cursorPosition, textInputCursorPosition(4)) // the combining mark joins the character before it
+
+	ti.selectingFrom = 2
+	ti.updateSelectionBounds()
+	is.Equal(ti.GetSelectedText(), "że\u0301")
+}
+
+func TestTextInput_UnicodeWords(t *testing.T) {
+	is := is.New(t)
+	in := newTestInputSource(t)
+
+	ti := newTestTextInput(in)
+	ti.SetValue("привет, gęśla jaźń")
+
+	ti.WordRight()
+	is.Equal(ti.cursorPosition, textInputCursorPosition(6))
+
+	ti.WordRight()
+	is.Equal(ti.cursorPosition, textInputCursorPosition(13))
+
+	ti.End()
+	ti.WordLeft()
+	is.Equal(ti.cursorPosition, textInputCursorPosition(14))
+
+	ti.BackspaceWord()
+	is.Equal(ti.Value(), "привет, jaźń")
+	is.Equal(ti.cursorPosition, textInputCursorPosition(8))
+
+	ti.Home()
+	ti.DeleteWord()
+	is.Equal(ti.Value(), ", jaźń")
+}
@@ -0,0 +1,87 @@
+package component
+
+import (
+	"github.com/fglo/chopstiqs/event"
+)
+
+// ToggleButton is a button that stays pressed when it's clicked and is released by the next click, like the tools of a tool palette.
+type ToggleButton struct {
+	Button
+	checked bool
+
+	ToggledEvent *event.Event[*ToggleButtonToggledEventArgs]
+}
+
+type ToggleButtonOptions struct {
+	ButtonOptions
+
+	// Checked makes the button pressed initially.
+	Checked bool
+}
+
+type ToggleButtonToggledEventArgs struct {
+	ToggleButton *ToggleButton
+	// Checked is the state the toggle button was toggled to
+	Checked bool
+}
+
+type ToggleButtonToggledHandlerFunc func(args *ToggleButtonToggledEventArgs)
+
+func NewToggleButton(opt *ToggleButtonOptions) *ToggleButton {
+	tb := &ToggleButton{
+		ToggledEvent: &event.Event[*ToggleButtonToggledEventArgs]{},
+	}
+
+	var buttonOptions *ButtonOptions
+	if opt != nil {
+		buttonOptions = &opt.ButtonOptions
+	}
+
+	tb.Button.init(buttonOptions)
+
+	if opt != nil && opt.Checked {
+		tb.checked = true
+		tb.setLatched(true)
+	}
+
+	tb.ClickedEvent.AddDefaultHandler(func(args *ButtonClickedEventArgs) {
+		tb.Toggle()
+	})
+
+	return tb
+}
+
+func (tb *ToggleButton) AddToggledHandler(f ToggleButtonToggledHandlerFunc) event.RemoveHandlerFunc {
+	return tb.ToggledEvent.AddHandler(event.HandlerFunc[*ToggleButtonToggledEventArgs](f))
+}
+
+// AddCheckedChangedHandler registers a handler called when the toggle button is pressed or released by toggling it.
+func (tb *ToggleButton) AddCheckedChangedHandler(f CheckedChangedHandlerFunc) event.RemoveHandlerFunc {
+	return tb.AddToggledHandler(func(args *ToggleButtonToggledEventArgs) {
+		f(&CheckedChangedEventArgs{Toggler: tb, Checked: args.Checked})
+	})
+}
+
+// Set presses or releases the toggle button. The ToggledEvent is fired only if the state changes.
+func (tb *ToggleButton) Set(checked bool) {
+	if tb.checked == checked {
+		return
+	}
+
+	tb.checked = checked
+	tb.setLatched(checked)
+
+	event.Fire(tb.eventManager, tb.ToggledEvent, &ToggleButtonToggledEventArgs{
+		ToggleButton: tb,
+		Checked:      tb.checked,
+	})
+}
+
+// Checked returns whether the toggle button is pressed.
+func (tb *ToggleButton) Checked() bool {
+	return tb.checked
+}
+
+func (tb *ToggleButton) Toggle() {
+	tb.Set(!tb.checked)
+}
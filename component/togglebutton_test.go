@@ -0,0 +1,49 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/matryer/is"
+)
+
+func TestToggleButton_Click(t *testing.T) {
+	is := is.New(t)
+
+	tb := NewToggleButton(&ToggleButtonOptions{
+		ButtonOptions: ButtonOptions{Label: NewLabel("pencil", nil)},
+	})
+	tb.SetEventManager(event.NewManager())
+
+	toggled := 0
+	tb.AddToggledHandler(func(args *ToggleButtonToggledEventArgs) {
+		toggled++
+	})
+
+	leftMouseButtonClick(t, &tb.component)
+	is.True(tb.Checked())
+	is.True(tb.latched) // the button stays pressed after it's released
+	is.True(tb.label.Inverted)
+	is.Equal(toggled, 1)
+
+	leftMouseButtonClick(t, &tb.component)
+	is.True(!tb.Checked())
+	is.True(!tb.latched)
+	is.True(!tb.label.Inverted)
+	is.Equal(toggled, 2)
+
+	tb.Activate()
+	tb.eventManager.HandleFired()
+	is.True(tb.Checked())
+	is.Equal(toggled, 3)
+}
+
+func TestToggleButton_Checked(t *testing.T) {
+	is := is.New(t)
+
+	tb := NewToggleButton(&ToggleButtonOptions{Checked: true})
+	tb.SetEventManager(event.NewManager())
+	is.True(tb.Checked())
+	is.True(tb.latched)
+
+}
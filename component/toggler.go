@@ -0,0 +1,25 @@
+package component
+
+import (
+	"github.com/fglo/chopstiqs/event"
+)
+
+// Toggler is implemented by the components that are checked and unchecked by clicking them,
+// like checkboxes, toggle switches and toggle buttons.
+type Toggler interface {
+	Component
+	Checked() bool
+	Set(checked bool)
+	Toggle()
+	// AddCheckedChangedHandler registers a handler called when the component is checked or unchecked.
+	// It's called from the component's ToggledEvent, so the handler can be added to any toggler.
+	AddCheckedChangedHandler(f CheckedChangedHandlerFunc) event.RemoveHandlerFunc
+}
+
+// CheckedChangedEventArgs are the arguments of the changes of a toggler's state.
+type CheckedChangedEventArgs struct {
+	Toggler Toggler
+	Checked bool
+}
+
+type CheckedChangedHandlerFunc func(args *CheckedChangedEventArgs)
@@ -0,0 +1,48 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/matryer/is"
+)
+
+func TestToggler_AddCheckedChangedHandler(t *testing.T) {
+	is := is.New(t)
+
+	togglers := []Toggler{NewToggleButton(nil), NewToggleSwitch(nil), NewCheckBox(nil)}
+	for _, toggler := range togglers {
+		eventManager := event.NewManager()
+		toggler.SetEventManager(eventManager)
+
+		var changes []bool
+		toggler.AddCheckedChangedHandler(func(args *CheckedChangedEventArgs) {
+			is.Equal(args.Toggler, toggler)
+			changes = append(changes, args.Checked)
+		})
+
+		toggler.Set(true)
+		toggler.Set(true)
+		toggler.Toggle()
+		eventManager.HandleFired()
+
+		is.True(!toggler.Checked())
+		is.Equal(changes, []bool{true, false})
+	}
+
+	// an indeterminate checkbox isn't checked, so becoming indeterminate from unchecked isn't a change
+	cb := NewCheckBox(nil)
+	cb.SetEventManager(event.NewManager())
+
+	var changes []bool
+	cb.AddCheckedChangedHandler(func(args *CheckedChangedEventArgs) {
+		changes = append(changes, args.Checked)
+	})
+
+	cb.SetState(CheckBoxIndeterminate)
+	cb.SetState(CheckBoxChecked)
+	cb.SetState(CheckBoxIndeterminate)
+	cb.eventManager.HandleFired()
+
+	is.Equal(changes, []bool{true, false})
+}
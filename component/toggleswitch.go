@@ -0,0 +1,291 @@
+package component
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/tween"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// toggleSwitchDefaultAnimationDuration is the duration of the knob's movement between the states.
+var toggleSwitchDefaultAnimationDuration = 120 * time.Millisecond
+
+// ToggleSwitch is an on/off switch with a knob sliding between the ends of its track.
+type ToggleSwitch struct {
+	component
+	checked bool
+
+	ToggledEvent *event.Event[*ToggleSwitchToggledEventArgs]
+
+	label *Label
+
+	swWidth  int
+	swHeight int
+
+	// knobPosition is the position of the knob on the track, from 0 when unchecked to 1 when checked
+	knobPosition      float64
+	animationDuration time.Duration
+	easing            tween.Easing
+	// knobAnimator is the animator playing the knob's tween
+	knobAnimator *tween.Animator
+	knobTween    *tween.Tween
+
+	drawer ToggleSwitchDrawer
+}
+
+type ToggleSwitchOptions struct {
+	Width  option.OptInt
+	Height option.OptInt
+
+	Label *Label
+
+	Padding *Padding
+	Tooltip *Tooltip
+
+	Drawer ToggleSwitchDrawer
+
+	// AnimationDuration is the duration of the knob's movement between the states. If not set, it's 120 milliseconds.
+	// A negative duration moves the knob immediately.
+	AnimationDuration time.Duration
+	// Easing is the easing of the knob's movement. If not set, tween.OutQuad is used.
+	Easing tween.Easing
+}
+
+type ToggleSwitchToggledEventArgs struct {
+	ToggleSwitch *ToggleSwitch
+	// Checked is the state the toggle switch was toggled to
+	Checked bool
+}
+
+type ToggleSwitchToggledHandlerFunc func(args *ToggleSwitchToggledEventArgs)
+
+func NewToggleSwitch(opt *ToggleSwitchOptions) *ToggleSwitch {
+	sw := &ToggleSwitch{
+		ToggledEvent: &event.Event[*ToggleSwitchToggledEventArgs]{},
+
+		swWidth:  18,
+		swHeight: 10,
+
+		animationDuration: toggleSwitchDefaultAnimationDuration,
+		easing:            tween.OutQuad,
+
+		drawer: DefaultToggleSwitchDrawer{
+			Color:         color.RGBA{230, 230, 230, 255},
+			ColorChecked:  color.RGBA{100, 160, 100, 255},
+			ColorDisabled: color.RGBA{150, 150, 150, 255},
+			ColorFocused:  color.RGBA{90, 160, 250, 255},
+		},
+	}
+
+	if opt != nil {
+		if opt.Width.IsSet() {
+			sw.swWidth = opt.Width.Val()
+		}
+
+		if opt.Height.IsSet() {
+			sw.swHeight = opt.Height.Val()
+		}
+
+		if opt.AnimationDuration != 0 {
+			sw.animationDuration = opt.AnimationDuration
+		}
+
+		if opt.Easing != nil {
+			sw.easing = opt.Easing
+		}
+	}
+
+	sw.SetDimensions(sw.swWidth, sw.swHeight)
+
+	if opt != nil {
+		if opt.Label != nil {
+			sw.SetLabel(opt.Label)
+		}
+
+		if opt.Drawer != nil {
+			sw.drawer = opt.Drawer
+		}
+	}
+
+	sw.setUpComponent(opt)
+
+	return sw
+}
+
+func (sw *ToggleSwitch) setUpComponent(opt *ToggleSwitchOptions) {
+	var componentOptions ComponentOptions
+
+	if opt != nil {
+		componentOptions = ComponentOptions{
+			Padding: opt.Padding,
+			Tooltip: opt.Tooltip,
+		}
+	}
+
+	sw.component.setUpComponent(&componentOptions)
+	sw.focusable = true
+
+	sw.MouseButtonReleasedEvent.AddDefaultHandler(func(args *ComponentMouseButtonReleasedEventArgs) {
+		if !sw.disabled && args.Inside {
+			sw.Toggle()
+		}
+	})
+}
+
+func (sw *ToggleSwitch) AddToggledHandler(f ToggleSwitchToggledHandlerFunc) event.RemoveHandlerFunc {
+	return sw.ToggledEvent.AddHandler(event.HandlerFunc[*ToggleSwitchToggledEventArgs](f))
+}
+
+// AddCheckedChangedHandler registers a handler called when the toggle switch is checked or unchecked.
+func (sw *ToggleSwitch) AddCheckedChangedHandler(f CheckedChangedHandlerFunc) event.RemoveHandlerFunc {
+	return sw.AddToggledHandler(func(args *ToggleSwitchToggledEventArgs) {
+		f(&CheckedChangedEventArgs{Toggler: sw, Checked: args.Checked})
+	})
+}
+
+// Label returns the label of the toggle switch, or nil if it has none.
+func (sw *ToggleSwitch) Label() *Label {
+	return sw.label
+}
+
+// SetLabel sets the label of the toggle switch and adjusts the toggle switch's dimensions accordingly.
+func (sw *ToggleSwitch) SetLabel(label *Label) {
+	label.setContainer(sw)
+	sw.label = label
+	sw.label.horizontalAlignment = option.AlignmentRight
+	sw.label.verticalAlignment = option.AlignmentCenteredVertically
+
+	if sw.label.padding.Left == 0 {
+		sw.label.SetPaddingLeft(3)
+	}
+
+	width := sw.width
+	if width <= sw.swWidth+sw.label.widthWithPadding {
+		width = sw.swWidth + sw.label.widthWithPadding
+	}
+
+	height := sw.height
+	if height <= sw.label.height {
+		height = sw.label.height
+	}
+
+	sw.SetDimensions(width, height)
+
+	sw.label.align()
+}
+
+// Set checks or unchecks the toggle switch, sliding the knob to the matching end of the track.
+// The ToggledEvent is fired only if the state changes.
+func (sw *ToggleSwitch) Set(checked bool) {
+	if sw.checked == checked {
+		return
+	}
+
+	sw.checked = checked
+	sw.moveKnob()
+
+	event.Fire(sw.eventManager, sw.ToggledEvent, &ToggleSwitchToggledEventArgs{
+		ToggleSwitch: sw,
+		Checked:      sw.checked,
+	})
+}
+
+func (sw *ToggleSwitch) Checked() bool {
+	return sw.checked
+}
+
+func (sw *ToggleSwitch) Toggle() {
+	sw.Set(!sw.checked)
+}
+
+// Activate toggles the toggle switch as if it was clicked with the mouse.
+// It is called when the toggle switch is focused and activated with the keyboard.
+func (sw *ToggleSwitch) Activate() {
+	if !sw.disabled {
+		sw.Toggle()
+	}
+}
+
+// KnobPosition returns the position of the knob on the track, from 0 at the unchecked end to 1 at the checked end.
+// It's between the two while the knob is moving.
+func (sw *ToggleSwitch) KnobPosition() float64 {
+	return sw.knobPosition
+}
+
+// moveKnob slides the knob to the end of the track matching the state with the root container's animator,
+// or moves it there immediately if the switch isn't in a tree with an animator.
+func (sw *ToggleSwitch) moveKnob() {
+	to := 0.0
+	if sw.checked {
+		to = 1
+	}
+
+	if sw.knobTween != nil {
+		sw.knobAnimator.Stop(sw.knobTween)
+		sw.knobTween = nil
+	}
+
+	animator := sw.component.animator()
+	if sw.animationDuration <= 0 || animator == nil {
+		sw.knobPosition = to
+		return
+	}
+
+	sw.knobAnimator = animator
+	sw.knobTween = tween.Float(sw.knobPosition, to, sw.animationDuration, sw.easing, func(value float64) {
+		sw.knobPosition = value
+	})
+	sw.knobAnimator.Play(sw.knobTween)
+}
+
+func (sw *ToggleSwitch) SetPosition(posX, posY float64) {
+	sw.component.SetPosition(posX, posY)
+	if sw.label != nil {
+		sw.label.RecalculateAbsPosition()
+	}
+}
+
+func (sw *ToggleSwitch) RecalculateAbsPosition() {
+	sw.component.RecalculateAbsPosition()
+	if sw.label != nil {
+		sw.label.RecalculateAbsPosition()
+	}
+}
+
+func (sw *ToggleSwitch) SetBackgroundColor(color color.RGBA) {
+	sw.container.SetBackgroundColor(color)
+}
+
+func (sw *ToggleSwitch) GetBackgroundColor() color.RGBA {
+	return sw.container.GetBackgroundColor()
+}
+
+func (sw *ToggleSwitch) FireEvents(in input.InputSource) {
+	if sw.label != nil {
+		sw.label.FireEvents(in)
+	}
+
+	sw.component.FireEvents(in)
+}
+
+func (sw *ToggleSwitch) Draw() *ebiten.Image {
+	if sw.hidden {
+		return sw.image
+	}
+
+	sw.drawer.Draw(sw)
+
+	if sw.label != nil {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(sw.label.Position())
+		sw.image.DrawImage(sw.label.Draw(), op)
+	}
+
+	sw.component.Draw()
+
+	return sw.image
+}
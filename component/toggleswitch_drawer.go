@@ -0,0 +1,80 @@
+package component
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/fglo/chopstiqs/tween"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+type ToggleSwitchDrawer interface {
+	Draw(toggleSwitch *ToggleSwitch) *ebiten.Image
+}
+
+// DefaultToggleSwitchDrawer draws the toggle switch as a track with rounded corners and a square knob.
+// The track fills with ColorChecked as the knob moves to the checked end.
+type DefaultToggleSwitchDrawer struct {
+	Color         color.RGBA
+	ColorChecked  color.RGBA
+	ColorDisabled color.RGBA
+	// ColorFocused is the color of the focused toggle switch's border. If it's transparent, the focus isn't drawn.
+	ColorFocused color.RGBA
+}
+
+func (d DefaultToggleSwitchDrawer) Draw(sw *ToggleSwitch) *ebiten.Image {
+	arr := make([]byte, sw.component.pixelRows*sw.component.pixelCols)
+	backgroundColor := sw.container.GetBackgroundColor()
+
+	clr := d.Color
+	if sw.disabled && d.ColorDisabled.A > 0 {
+		clr = d.ColorDisabled
+	}
+
+	borderColor := clr
+	if sw.focused && d.ColorFocused.A > 0 {
+		borderColor = d.ColorFocused
+	}
+
+	trackColor := tween.LerpColor(backgroundColor, d.ColorChecked, sw.KnobPosition())
+
+	knobSize := sw.swHeight - 4
+	knobX := 2 + int(math.Round(sw.KnobPosition()*float64(sw.swWidth-knobSize-4)))
+
+	for y := 0; y < sw.swHeight; y++ {
+		rowNumber := sw.component.pixelCols * (y + sw.padding.Top)
+
+		for x := 0; x < sw.swWidth; x++ {
+			colId := (x + sw.padding.Left) * 4
+
+			var c color.RGBA
+			switch {
+			case d.isCorner(sw, x, y):
+				c = backgroundColor
+			case d.isBorder(sw, x, y):
+				c = borderColor
+			case x >= knobX && x < knobX+knobSize && y >= 2 && y < 2+knobSize:
+				c = clr
+			default:
+				c = trackColor
+			}
+
+			arr[colId+rowNumber] = c.R
+			arr[colId+1+rowNumber] = c.G
+			arr[colId+2+rowNumber] = c.B
+			arr[colId+3+rowNumber] = c.A
+		}
+	}
+
+	sw.image.WritePixels(arr)
+
+	return sw.image
+}
+
+func (d DefaultToggleSwitchDrawer) isCorner(sw *ToggleSwitch, x, y int) bool {
+	return (x == 0 || x == sw.swWidth-1) && (y == 0 || y == sw.swHeight-1)
+}
+
+func (d DefaultToggleSwitchDrawer) isBorder(sw *ToggleSwitch, x, y int) bool {
+	return x == 0 || x == sw.swWidth-1 || y == 0 || y == sw.swHeight-1
+}
@@ -0,0 +1,81 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/fglo/chopstiqs/tween"
+	"github.com/matryer/is"
+)
+
+func TestToggleSwitch_Click(t *testing.T) {
+	is := is.New(t)
+
+	sw := NewToggleSwitch(&ToggleSwitchOptions{AnimationDuration: -1})
+	sw.SetEventManager(event.NewManager())
+
+	toggled := 0
+	sw.AddToggledHandler(func(args *ToggleSwitchToggledEventArgs) {
+		toggled++
+	})
+
+	leftMouseButtonClick(t, &sw.component)
+	is.True(sw.Checked())
+	is.Equal(sw.KnobPosition(), 1.0) // the knob is moved immediately without the animation
+	is.Equal(toggled, 1)
+
+	sw.Set(true)
+	sw.eventManager.HandleFired()
+	is.Equal(toggled, 1)
+
+	sw.Activate()
+	sw.eventManager.HandleFired()
+	is.True(!sw.Checked())
+	is.Equal(toggled, 2)
+}
+
+func TestToggleSwitch_KnobAnimation(t *testing.T) {
+	is := is.New(t)
+
+	sw := NewToggleSwitch(&ToggleSwitchOptions{
+		AnimationDuration: time.Second,
+		Easing:            tween.Linear,
+	})
+
+	// the knob is moved immediately until the switch is in a tree with an animator
+	sw.Set(true)
+	is.Equal(sw.KnobPosition(), 1.0)
+	sw.Set(false)
+
+	eventManager := newTestEventManager()
+	clock := eventManager.Scheduler().Clock().(*timer.FakeClock)
+	animator := tween.NewAnimator(eventManager.Scheduler(), eventManager)
+
+	root := newTestRootContainer(sw)
+	root.SetEventManager(eventManager)
+	root.SetAnimator(animator)
+
+	wait := func(d time.Duration) {
+		clock.Advance(d)
+		eventManager.Scheduler().Tick()
+		eventManager.HandleFired()
+	}
+
+	sw.Set(true)
+	is.True(sw.Checked()) // the state is set immediately
+	is.Equal(sw.KnobPosition(), 0.0)
+	is.True(animator.Playing(sw.knobTween)) // the knob is animated by the root container's animator
+
+	wait(500 * time.Millisecond)
+	is.Equal(sw.KnobPosition(), 0.5)
+
+	// toggling again turns the knob back from where it is
+	sw.Set(false)
+	wait(500 * time.Millisecond)
+	is.Equal(sw.KnobPosition(), 0.25)
+
+	wait(500 * time.Millisecond)
+	is.Equal(sw.KnobPosition(), 0.0)
+}
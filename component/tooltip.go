@@ -0,0 +1,142 @@
+package component
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+)
+
+// tooltipDefaultDelay is the time the cursor has to hover over a component before its tooltip is shown.
+var tooltipDefaultDelay = 500 * time.Millisecond
+
+// Tooltip is the content shown near the mouse cursor after it hovered over a component for a while.
+// It's hidden when the cursor leaves the component or a mouse button is pressed.
+type Tooltip struct {
+	content Component
+	delay   time.Duration
+}
+
+type TooltipOptions struct {
+	// Delay is the time the cursor has to hover over the component before the tooltip is shown. If not set, it's half a second.
+	Delay time.Duration
+}
+
+// NewTooltip creates a tooltip showing the content.
+func NewTooltip(content Component, opt *TooltipOptions) *Tooltip {
+	t := &Tooltip{
+		content: content,
+		delay:   tooltipDefaultDelay,
+	}
+
+	if opt != nil && opt.Delay > 0 {
+		t.delay = opt.Delay
+	}
+
+	return t
+}
+
+// NewTextTooltip creates a tooltip showing the text on a dark background.
+func NewTextTooltip(text string, opt *TooltipOptions) *Tooltip {
+	content := NewContainer(&ContainerOptions{
+		Layout:  &VerticalListLayout{},
+		Padding: &Padding{Top: 3, Bottom: 3, Left: 4, Right: 4},
+	})
+	content.SetBackgroundColor(color.RGBA{40, 40, 40, 240})
+	content.AddComponent(NewLabel(text, nil))
+
+	return NewTooltip(content, opt)
+}
+
+// Content returns the component shown by the tooltip.
+func (t *Tooltip) Content() Component {
+	return t.content
+}
+
+// Delay returns the time the cursor has to hover over the component before the tooltip is shown.
+func (t *Tooltip) Delay() time.Duration {
+	return t.delay
+}
+
+// Tooltip returns the component's tooltip.
+func (c *component) Tooltip() *Tooltip {
+	return c.tooltip
+}
+
+// SetTooltip sets the component's tooltip. Containers don't show tooltips, as they don't fire cursor events.
+func (c *component) SetTooltip(tooltip *Tooltip) {
+	c.hideTooltip()
+	c.tooltip = tooltip
+}
+
+// setUpTooltip shows the tooltip after the cursor hovered over the component for the tooltip's delay
+// and hides it when the cursor leaves the component or a mouse button is pressed over it.
+func (c *component) setUpTooltip() {
+	c.CursorEnterEvent.AddDefaultHandler(func(args *ComponentCursorEnterEventArgs) {
+		if c.tooltip == nil || c.tooltipShown || c.tooltipSuppressed || c.tooltipTimer.Active() {
+			return
+		}
+
+		c.tooltipTimer = c.eventManager.Scheduler().After(c.tooltip.delay, c.showTooltip)
+	})
+
+	c.CursorExitEvent.AddDefaultHandler(func(args *ComponentCursorExitEventArgs) {
+		c.tooltipSuppressed = false
+		c.hideTooltip()
+	})
+
+	c.MouseButtonJustPressedEvent.AddDefaultHandler(func(args *ComponentMouseButtonJustPressedEventArgs) {
+		// the tooltip isn't shown again until the cursor leaves the component
+		c.tooltipSuppressed = true
+		c.hideTooltip()
+	})
+}
+
+func (c *component) showTooltip() {
+	if c.tooltip == nil {
+		return
+	}
+
+	c.tooltipShown = true
+
+	dispatch(c, tooltipEvent, &ComponentTooltipEventArgs{
+		Component: c.target(),
+		Tooltip:   c.tooltip,
+		Shown:     true,
+	}, true)
+}
+
+func (c *component) hideTooltip() {
+	c.tooltipTimer.Cancel()
+
+	if !c.tooltipShown {
+		return
+	}
+
+	c.tooltipShown = false
+
+	dispatch(c, tooltipEvent, &ComponentTooltipEventArgs{
+		Component: c.target(),
+		Tooltip:   c.tooltip,
+		Shown:     false,
+	}, true)
+}
+
+func tooltipEvent(c *component) *event.Event[*ComponentTooltipEventArgs] {
+	return c.TooltipEvent
+}
+
+type ComponentTooltipHandlerFunc func(args *ComponentTooltipEventArgs) //nolint:golint
+// ComponentTooltipEventArgs are the arguments for the events fired when the component's tooltip should be shown or hidden.
+// The event propagates up to the root container, where the gui shows the tooltip above all other components.
+type ComponentTooltipEventArgs struct {
+	EventArgs
+
+	Component Component
+	Tooltip   *Tooltip
+	Shown     bool
+}
+
+func (c *component) AddTooltipHandler(f ComponentTooltipHandlerFunc) event.RemoveHandlerFunc {
+	return c.TooltipEvent.AddHandler(event.HandlerFunc[*ComponentTooltipEventArgs](f))
+}
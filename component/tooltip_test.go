@@ -0,0 +1,81 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/timer"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestTooltip(t *testing.T) {
+	is := is.New(t)
+
+	clock := timer.NewFakeClock()
+	in := input.NewFakeInputSource()
+
+	tooltip := NewTextTooltip("help", &TooltipOptions{Delay: time.Second})
+	b := NewButton(&ButtonOptions{Tooltip: tooltip})
+	root := newTestRootContainer(b)
+	root.eventManager.SetScheduler(timer.NewScheduler(clock))
+
+	var shown []bool
+	root.AddTooltipHandler(func(args *ComponentTooltipEventArgs) {
+		is.Equal(args.Component, b)
+		is.Equal(args.Tooltip, tooltip)
+		shown = append(shown, args.Shown)
+	})
+
+	step := func(d time.Duration) {
+		clock.Advance(d)
+		in.Update()
+		root.eventManager.Scheduler().Tick()
+		root.FireEvents(in)
+		root.eventManager.HandleFired()
+	}
+
+	x, y := b.AbsPosition()
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(0)
+	step(900 * time.Millisecond)
+	is.Equal(len(shown), 0) // not shown before the delay
+
+	step(100 * time.Millisecond)
+	step(0)
+	is.Equal(shown, []bool{true})
+
+	in.MoveCursor(int(x)+1, int(y)+100)
+	step(0)
+	is.Equal(shown, []bool{true, false}) // hidden when the cursor leaves
+
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(0)
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	step(0)
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	step(2 * time.Second)
+	step(0)
+	is.Equal(len(shown), 2) // not shown after the press until the cursor leaves
+
+	in.MoveCursor(int(x)+1, int(y)+100)
+	step(0)
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(0)
+	step(time.Second)
+	step(0)
+	is.Equal(shown, []bool{true, false, true})
+
+	b.SetTooltip(nil)
+	root.eventManager.HandleFired()
+	is.Equal(shown, []bool{true, false, true, false})
+}
+
+func TestTooltip_defaultDelay(t *testing.T) {
+	is := is.New(t)
+
+	tooltip := NewTooltip(NewLabel("help", nil), nil)
+	is.Equal(tooltip.Delay(), tooltipDefaultDelay)
+
+}
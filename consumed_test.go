@@ -0,0 +1,149 @@
+package chopstiqs
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestGUI_CursorOverGUI(t *testing.T) {
+	is := is.New(t)
+
+	b := component.NewButton(nil)
+
+	gui, in := newTestGUI(t, b)
+	step(t, gui)
+
+	x, y := b.AbsPosition()
+	w, h := b.Dimensions()
+
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(t, gui)
+	is.True(gui.CursorOverGUI())
+
+	in.MoveCursor(int(x)+w+50, int(y)+h+50)
+	step(t, gui)
+	is.True(!gui.CursorOverGUI()) // the root container has no background
+
+	b.SetHidden(true)
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(t, gui)
+	is.True(!gui.CursorOverGUI())
+}
+
+func TestGUI_CursorOverGUI_containerBackground(t *testing.T) {
+	is := is.New(t)
+
+	container := component.NewContainer(&component.ContainerOptions{
+		Width:  option.Int(50),
+		Height: option.Int(50),
+	})
+	container.SetBackgroundColor(color.RGBA{40, 40, 40, 255})
+
+	gui, in := newTestGUI(t, container)
+	step(t, gui)
+
+	in.MoveCursor(25, 25)
+	step(t, gui)
+	is.True(gui.CursorOverGUI())
+
+	in.MoveCursor(100, 100)
+	step(t, gui)
+	is.True(!gui.CursorOverGUI())
+}
+
+func TestGUI_MouseConsumed(t *testing.T) {
+	is := is.New(t)
+
+	b := component.NewButton(nil)
+
+	gui, in := newTestGUI(t, b)
+	step(t, gui)
+
+	x, y := b.AbsPosition()
+
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(t, gui)
+	is.True(!gui.MouseConsumed()) // hovering alone isn't consumed
+
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+	is.True(gui.MouseConsumed())
+
+	in.MoveCursor(150, 150)
+	step(t, gui)
+	is.True(gui.MouseConsumed()) // dragged out of the gui
+
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+	is.True(gui.MouseConsumed()) // the release ends the drag
+
+	step(t, gui)
+	is.True(!gui.MouseConsumed())
+
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+	is.True(!gui.MouseConsumed()) // pressed outside of the gui
+
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(t, gui)
+	is.True(!gui.MouseConsumed()) // dragged into the gui
+
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+
+	in.ScrollWheel(0, 1)
+	step(t, gui)
+	is.True(gui.MouseConsumed())
+}
+
+func TestGUI_KeyboardConsumed(t *testing.T) {
+	is := is.New(t)
+
+	b := component.NewButton(nil)
+	ti := component.NewTextInput(nil)
+
+	gui, in := newTestGUI(t, b, ti)
+	step(t, gui)
+
+	in.PressKey(ebiten.KeyA)
+	step(t, gui)
+	is.True(!gui.KeyboardConsumed())
+	in.ReleaseAll()
+	step(t, gui)
+
+	in.PressKey(ebiten.KeyTab)
+	step(t, gui)
+	is.True(gui.KeyboardConsumed()) // focus moved to the button
+	in.ReleaseAll()
+	step(t, gui)
+	is.True(!gui.KeyboardConsumed())
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), ti)
+	is.True(gui.KeyboardConsumed())
+
+	ti.SetDisabled(true)
+	step(t, gui)
+	is.True(!gui.KeyboardConsumed())
+}
+
+func TestGUI_KeyboardConsumed_TextArea(t *testing.T) {
+	is := is.New(t)
+
+	ta := component.NewTextArea(nil)
+
+	gui, in := newTestGUI(t, ta)
+	step(t, gui)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), ta)
+
+	in.PressKey(ebiten.KeyA)
+	step(t, gui)
+	is.True(gui.KeyboardConsumed())
+}
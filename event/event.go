@@ -1,32 +1,61 @@
 package event
 
-// Event represents an event that can be fired.
-type Event struct {
-	idCounter uint32
-	handlers  []handler
+// Event represents an event that can be fired with arguments of type T.
+type Event[T any] struct {
+	idCounter       uint32
+	handlers        []handler[T]
+	captureHandlers []handler[T]
+	defaultHandlers []handler[T]
+	// bubblingDefaultHandlers are the default handlers also called for the events of the object's descendants
+	bubblingDefaultHandlers []handler[T]
 }
 
 // handler represents a handler that is registered with an event. It contains the handler function and the
 // unique id of the handler. This id is used to remove the handler from the event.
-type handler struct {
+type handler[T any] struct {
 	id     uint32
-	handle HandlerFunc
+	handle HandlerFunc[T]
 }
 
 // A HandlerFunc is a function that receives and handles an event. When firing an event using
-// EventManager.Fire, arbitrary event arguments may be passed that are in turn passed on to the handler function.
-type HandlerFunc func(args interface{})
+// Fire, the event arguments are in turn passed on to the handler function.
+type HandlerFunc[T any] func(args T)
 
 // RemoveHandlerFunc is a function that removes a handler from an event.
 type RemoveHandlerFunc func()
 
 // AddHandler registers event handler with event. It returns a function to remove handler from event.
-func (e *Event) AddHandler(h HandlerFunc) RemoveHandlerFunc {
+// The handler is called when the event reaches its target and, for propagated events, in the bubble phase.
+func (e *Event[T]) AddHandler(h HandlerFunc[T]) RemoveHandlerFunc {
+	return e.addHandler(&e.handlers, h)
+}
+
+// AddCaptureHandler registers event handler called in the capture phase of propagated events,
+// before the handlers of the event's target are. It returns a function to remove handler from event.
+func (e *Event[T]) AddCaptureHandler(h HandlerFunc[T]) RemoveHandlerFunc {
+	return e.addHandler(&e.captureHandlers, h)
+}
+
+// AddDefaultHandler registers the default behaviour of the event's target. Default handlers are called
+// after the event propagated, unless one of the handlers prevented the default. It returns a function to remove handler from event.
+func (e *Event[T]) AddDefaultHandler(h HandlerFunc[T]) RemoveHandlerFunc {
+	return e.addHandler(&e.defaultHandlers, h)
+}
+
+// AddBubblingDefaultHandler registers a default behaviour the object performs for its own events and for the propagated events
+// of its descendants, like a scroll container scrolling with the wheel turned over its content. After the target's default handlers,
+// the bubbling default handlers are called from the target up to the root, until one of the handlers stops the propagation.
+// They aren't called if the default was prevented. It returns a function to remove handler from event.
+func (e *Event[T]) AddBubblingDefaultHandler(h HandlerFunc[T]) RemoveHandlerFunc {
+	return e.addHandler(&e.bubblingDefaultHandlers, h)
+}
+
+func (e *Event[T]) addHandler(handlers *[]handler[T], h HandlerFunc[T]) RemoveHandlerFunc {
 	e.idCounter++
 
 	id := e.idCounter
 
-	e.handlers = append(e.handlers, handler{
+	*handlers = append(*handlers, handler[T]{
 		id:     id,
 		handle: h,
 	})
@@ -37,29 +66,28 @@ func (e *Event) AddHandler(h HandlerFunc) RemoveHandlerFunc {
 }
 
 // AddOneTimeHandler registers event handler with event. When event fires, handler is removed from it immediately.
-func (e *Event) AddOneTimeHandler(handler HandlerFunc) {
+// It returns a function to remove handler from event before it fires.
+func (e *Event[T]) AddOneTimeHandler(handler HandlerFunc[T]) RemoveHandlerFunc {
 	var removeHandler RemoveHandlerFunc
 
-	oneShotHandlerWrapperFunc := func(args interface{}) {
+	oneShotHandlerWrapperFunc := func(args T) {
 		removeHandler()
 		handler(args)
 	}
 
 	removeHandler = e.AddHandler(oneShotHandlerWrapperFunc)
+
+	return removeHandler
 }
 
-func (e *Event) removeHandler(id uint32) {
-	index := -1
-	for i, handler := range e.handlers {
-		if handler.id == id {
-			index = i
-			break
+func (e *Event[T]) removeHandler(id uint32) {
+	for _, handlers := range []*[]handler[T]{&e.handlers, &e.captureHandlers, &e.defaultHandlers, &e.bubblingDefaultHandlers} {
+		for i, handler := range *handlers {
+			if handler.id == id {
+				// the handlers are copied, so the removal doesn't affect the handlers being called
+				*handlers = append((*handlers)[:i:i], (*handlers)[i+1:]...)
+				return
+			}
 		}
 	}
-
-	if index < 0 {
-		return
-	}
-
-	e.handlers = append(e.handlers[:index], e.handlers[index+1:]...)
 }
@@ -1,52 +1,105 @@
 package event
 
+import (
+	"sync"
+
+	"github.com/fglo/chopstiqs/timer"
+)
+
 // Manager contains queue of fired events.
 // Its role is to handle fire events.
 type Manager struct {
-	firedEvents []*Fired
-}
+	firedEvents []func()
 
-// Fired represents an event that has been fired.
-type Fired struct {
-	event *Event
-	args  interface{}
+	// postedMu guards posted, which, unlike firedEvents, is filled from any goroutine.
+	postedMu sync.Mutex
+	posted   []func()
+
+	scheduler *timer.Scheduler
 }
 
+// NewManager creates a new manager. It has no scheduler until one is set with SetScheduler.
 func NewManager() *Manager {
 	return &Manager{
-		firedEvents: make([]*Fired, 0),
+		firedEvents: make([]func(), 0),
 	}
 }
 
-// Fire fires an event to all registered handlers. Arbitrary event arguments may be passed
-// which are in turn passed on to event handlers.
-//
-// Events are not fired directly, but are put into a deferred queue. This queue is then
-// processed by the GUI.
-func (m *Manager) Fire(e *Event, args any) {
+// Scheduler returns the scheduler the components use for delayed and repeated actions, like key repeat,
+// or nil if it isn't set.
+func (m *Manager) Scheduler() *timer.Scheduler {
 	if m == nil {
+		return nil
+	}
+
+	return m.scheduler
+}
+
+// SetScheduler sets the scheduler the components use for delayed and repeated actions.
+// The scheduler is ticked by its owner, usually the GUI.
+func (m *Manager) SetScheduler(scheduler *timer.Scheduler) {
+	m.scheduler = scheduler
+}
+
+// Fire fires an event to all registered handlers. The event arguments are passed on to event handlers.
+//
+// Events are not fired directly, but are put into a deferred queue of the manager. This queue is then
+// processed by the GUI. Firing with a nil manager does nothing.
+func Fire[T any](m *Manager, e *Event[T], args T) {
+	Dispatch(m, []Node[T]{{Event: e}}, args)
+}
+
+// Dispatch fires an event propagated along the path, ordered from the root to the event's target.
+// The event's capture handlers are called from the root down to the target, then the target's handlers,
+// and then the handlers of the target's ancestors from its parent up to the root. Finally, the target's
+// default handlers and the bubbling default handlers from the target up to the root are called,
+// unless one of the handlers prevented the default.
+//
+// Like Fire, Dispatch puts the event into the deferred queue.
+func Dispatch[T any](m *Manager, path []Node[T], args T) {
+	if m == nil || len(path) == 0 {
 		return
 	}
 
-	m.firedEvents = append(m.firedEvents, &Fired{
-		event: e,
-		args:  args,
+	m.firedEvents = append(m.firedEvents, func() {
+		propagate(path, args)
 	})
 }
 
-// HandleFired processes the queue of fired events and calls their handlers.
+// Post queues the function to be called by the next HandleFired, before the fired events are handled.
+// Unlike Fire, Post is safe to call from any goroutine, so it can be used to update components
+// when background work finishes. Posting to a nil manager does nothing.
+func (m *Manager) Post(f func()) {
+	if m == nil {
+		return
+	}
+
+	m.postedMu.Lock()
+	m.posted = append(m.posted, f)
+	m.postedMu.Unlock()
+}
+
+// HandleFired calls the posted functions and processes the queue of fired events and calls their handlers.
+// Functions posted while HandleFired runs are called by its next call.
 func (m *Manager) HandleFired() {
 	if m == nil {
 		return
 	}
 
+	m.postedMu.Lock()
+	posted := m.posted
+	m.posted = nil
+	m.postedMu.Unlock()
+
+	for _, f := range posted {
+		f()
+	}
+
 	for len(m.firedEvents) > 0 {
 		fired := m.firedEvents[0]
 		m.firedEvents = m.firedEvents[1:]
 
-		for _, handler := range fired.event.handlers {
-			handler.handle(fired.args)
-		}
+		fired()
 	}
 
 	// resetting the deferredActions slice
@@ -0,0 +1,274 @@
+package event
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/matryer/is"
+)
+
+type testArgs struct {
+	Args
+}
+
+func newTestPath(names ...string) []Node[*testArgs] {
+	path := make([]Node[*testArgs], len(names))
+	for i, name := range names {
+		path[i] = Node[*testArgs]{Target: name, Event: &Event[*testArgs]{}}
+	}
+
+	return path
+}
+
+func TestManager_Dispatch_phases(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	path := newTestPath("root", "parent", "target")
+
+	var calls []string
+	for _, node := range path {
+		node := node
+		node.Event.AddCaptureHandler(func(args *testArgs) {
+			is.Equal(args.CurrentTarget(), node.Target)
+			is.Equal(args.Target(), "target")
+			calls = append(calls, "capture "+node.Target.(string))
+		})
+		node.Event.AddHandler(func(args *testArgs) {
+			calls = append(calls, "handle "+node.Target.(string))
+		})
+		node.Event.AddDefaultHandler(func(args *testArgs) {
+			calls = append(calls, "default "+node.Target.(string))
+		})
+	}
+
+	Dispatch(m, path, &testArgs{})
+	is.Equal(len(calls), 0) // deferred until handled
+
+	m.HandleFired()
+	is.Equal(calls, []string{
+		"capture root", "capture parent",
+		"capture target", "handle target",
+		"handle parent", "handle root",
+		"default target",
+	})
+}
+
+func TestManager_Dispatch_stopPropagation(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	path := newTestPath("root", "parent", "target")
+
+	var calls []string
+	path[1].Event.AddCaptureHandler(func(args *testArgs) {
+		calls = append(calls, "capture parent")
+		args.StopPropagation()
+	})
+	path[1].Event.AddCaptureHandler(func(args *testArgs) {
+		calls = append(calls, "capture parent again") // handlers of the current target are still called
+	})
+	path[2].Event.AddHandler(func(args *testArgs) {
+		calls = append(calls, "handle target")
+	})
+	path[0].Event.AddHandler(func(args *testArgs) {
+		calls = append(calls, "handle root")
+	})
+	path[2].Event.AddDefaultHandler(func(args *testArgs) {
+		calls = append(calls, "default target")
+	})
+
+	Dispatch(m, path, &testArgs{})
+	m.HandleFired()
+
+	is.Equal(calls, []string{"capture parent", "capture parent again", "default target"})
+}
+
+func TestManager_Dispatch_preventDefault(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	path := newTestPath("root", "target")
+
+	defaultCalled := false
+	path[0].Event.AddHandler(func(args *testArgs) {
+		args.PreventDefault()
+	})
+	path[1].Event.AddDefaultHandler(func(args *testArgs) {
+		defaultCalled = true
+	})
+
+	args := &testArgs{}
+	Dispatch(m, path, args)
+	m.HandleFired()
+
+	is.True(!defaultCalled)
+	is.True(args.DefaultPrevented())
+}
+
+func TestManager_Dispatch_bubblingDefault(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	path := newTestPath("root", "parent", "target")
+
+	var calls []string
+	handled := map[string]bool{}
+	for _, node := range path {
+		node := node
+		node.Event.AddBubblingDefaultHandler(func(args *testArgs) {
+			is.Equal(args.CurrentTarget(), node.Target)
+			calls = append(calls, "bubbling default "+node.Target.(string))
+			if handled[node.Target.(string)] {
+				args.StopPropagation()
+			}
+		})
+	}
+	path[2].Event.AddDefaultHandler(func(args *testArgs) {
+		calls = append(calls, "default target")
+	})
+
+	Dispatch(m, path, &testArgs{})
+	m.HandleFired()
+	is.Equal(calls, []string{"default target", "bubbling default target", "bubbling default parent", "bubbling default root"})
+
+	// the first object handling the event keeps it from the objects above it
+	calls = nil
+	handled["parent"] = true
+	Dispatch(m, path, &testArgs{})
+	m.HandleFired()
+	is.Equal(calls, []string{"default target", "bubbling default target", "bubbling default parent"})
+
+	// the target's default handlers keep the event from all of them
+	calls = nil
+	remove := path[2].Event.AddDefaultHandler(func(args *testArgs) {
+		args.StopPropagation()
+	})
+	Dispatch(m, path, &testArgs{})
+	m.HandleFired()
+	is.Equal(calls, []string{"default target"})
+	remove()
+
+	calls = nil
+	path[0].Event.AddHandler(func(args *testArgs) {
+		args.PreventDefault()
+	})
+	Dispatch(m, path, &testArgs{})
+	m.HandleFired()
+	is.Equal(len(calls), 0)
+}
+
+func TestManager_Fire(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	e := &Event[int]{}
+
+	var calls []string
+	e.AddDefaultHandler(func(args int) { calls = append(calls, "default") })
+	remove := e.AddHandler(func(args int) { calls = append(calls, "handle") })
+	e.AddCaptureHandler(func(args int) { calls = append(calls, "capture") })
+
+	Fire(m, e, 1) // arguments without Args can't stop the propagation
+	m.HandleFired()
+	is.Equal(calls, []string{"capture", "handle", "default"})
+
+	calls = nil
+	remove()
+	Fire(m, e, 2)
+	m.HandleFired()
+	is.Equal(calls, []string{"capture", "default"})
+}
+
+func TestEvent_AddOneTimeHandler(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	e := &Event[int]{}
+
+	var calls []int
+	e.AddOneTimeHandler(func(args int) { calls = append(calls, -args) })
+	e.AddHandler(func(args int) { calls = append(calls, args) })
+
+	Fire(m, e, 1)
+	Fire(m, e, 2)
+	m.HandleFired()
+
+	is.Equal(calls, []int{-1, 1, 2}) // removing the one time handler doesn't skip the next one
+}
+
+func TestManager_Scheduler(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	is.True(m.Scheduler() == nil) // the scheduler is set by the gui
+
+	scheduler := timer.NewScheduler(timer.NewFakeClock())
+	m.SetScheduler(scheduler)
+	is.Equal(m.Scheduler(), scheduler)
+
+	var nilManager *Manager
+	is.True(nilManager.Scheduler() == nil)
+}
+
+func TestManager_Post(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+	e := &Event[int]{}
+
+	var calls []int
+	e.AddHandler(func(args int) { calls = append(calls, args) })
+
+	const goroutines = 10
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Post(func() {
+				Fire(m, e, i) // posted functions are called on the handling goroutine, so they can fire events
+			})
+		}(i)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	// the game loop handles the fired events while the functions are posted
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+			m.HandleFired()
+		}
+	}
+	m.HandleFired()
+
+	is.Equal(len(calls), goroutines)
+}
+
+func TestManager_Post_whileHandling(t *testing.T) {
+	is := is.New(t)
+
+	m := NewManager()
+
+	var calls []string
+	m.Post(func() {
+		calls = append(calls, "first")
+		m.Post(func() {
+			calls = append(calls, "second")
+		})
+	})
+
+	m.HandleFired()
+	is.Equal(calls, []string{"first"}) // posted while handling, called by the next HandleFired
+
+	m.HandleFired()
+	is.Equal(calls, []string{"first", "second"})
+}
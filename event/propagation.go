@@ -0,0 +1,139 @@
+package event
+
+// Phase is the phase of an event's propagation through the component hierarchy.
+type Phase int
+
+const (
+	// PhaseNone means the event isn't being propagated.
+	PhaseNone Phase = iota
+	// PhaseCapture means the event travels from the root down to its target's parent.
+	PhaseCapture
+	// PhaseTarget means the event reached its target.
+	PhaseTarget
+	// PhaseBubble means the event travels from the target's parent up to the root.
+	PhaseBubble
+)
+
+// Args is the base of event arguments that can be propagated through the component hierarchy.
+// Embed it in event arguments to give their handlers access to the propagation state.
+type Args struct {
+	target             any
+	currentTarget      any
+	phase              Phase
+	propagationStopped bool
+	defaultPrevented   bool
+}
+
+// propagated is implemented by event arguments embedding Args.
+type propagated interface {
+	eventArgs() *Args
+}
+
+func (a *Args) eventArgs() *Args {
+	return a
+}
+
+// Target returns the object the event was dispatched to.
+func (a *Args) Target() any {
+	return a.target
+}
+
+// CurrentTarget returns the object whose handlers are currently called.
+func (a *Args) CurrentTarget() any {
+	return a.currentTarget
+}
+
+// Phase returns the current phase of the event's propagation.
+func (a *Args) Phase() Phase {
+	return a.phase
+}
+
+// StopPropagation stops the event from reaching handlers of the next objects on its propagation path.
+// The remaining handlers of the current object are still called.
+func (a *Args) StopPropagation() {
+	a.propagationStopped = true
+}
+
+// PropagationStopped returns whether StopPropagation has been called.
+func (a *Args) PropagationStopped() bool {
+	return a.propagationStopped
+}
+
+// PreventDefault prevents the target's default handlers from being called.
+func (a *Args) PreventDefault() {
+	a.defaultPrevented = true
+}
+
+// DefaultPrevented returns whether PreventDefault has been called.
+func (a *Args) DefaultPrevented() bool {
+	return a.defaultPrevented
+}
+
+// Node is an object on an event's propagation path together with its instance of the event.
+type Node[T any] struct {
+	Target any
+	Event  *Event[T]
+}
+
+// propagate calls the handlers of the nodes on the path, ordered from the root to the target,
+// in the capture, target and bubble phases, then the target's default handlers and the bubbling default handlers
+// of the nodes from the target up to the root.
+func propagate[T any](path []Node[T], args T) {
+	if len(path) == 0 {
+		return
+	}
+
+	var base *Args
+	if p, ok := any(args).(propagated); ok {
+		base = p.eventArgs()
+	} else {
+		base = &Args{}
+	}
+
+	target := path[len(path)-1]
+	base.target = target.Target
+	base.propagationStopped = false
+	base.defaultPrevented = false
+
+	call := func(node Node[T], phase Phase, handlerLists ...[]handler[T]) bool {
+		base.currentTarget = node.Target
+		base.phase = phase
+
+		for _, handlers := range handlerLists {
+			for _, handler := range handlers {
+				handler.handle(args)
+			}
+		}
+
+		return !base.propagationStopped
+	}
+
+	propagating := true
+	for i := 0; i < len(path)-1 && propagating; i++ {
+		propagating = call(path[i], PhaseCapture, path[i].Event.captureHandlers)
+	}
+
+	if propagating {
+		propagating = call(target, PhaseTarget, target.Event.captureHandlers, target.Event.handlers)
+	}
+
+	for i := len(path) - 2; i >= 0 && propagating; i-- {
+		propagating = call(path[i], PhaseBubble, path[i].Event.handlers)
+	}
+
+	if !base.defaultPrevented {
+		propagating = call(target, PhaseTarget, target.Event.defaultHandlers)
+
+		for i := len(path) - 1; i >= 0 && propagating; i-- {
+			phase := PhaseBubble
+			if i == len(path)-1 {
+				phase = PhaseTarget
+			}
+
+			propagating = call(path[i], phase, path[i].Event.bubblingDefaultHandlers)
+		}
+	}
+
+	base.currentTarget = nil
+	base.phase = PhaseNone
+}
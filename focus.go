@@ -0,0 +1,137 @@
+package chopstiqs
+
+import (
+	"sort"
+
+	"github.com/fglo/chopstiqs/component"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// activatable is implemented by components that can be activated with the keyboard when focused, like buttons and checkboxes.
+type activatable interface {
+	Activate()
+}
+
+// componentsContainer is implemented by components that contain other components.
+type componentsContainer interface {
+	Components() []component.Component
+}
+
+// FocusNext moves the focus to the next focusable component.
+func (gui *GUI) FocusNext() {
+	gui.moveFocus(1)
+}
+
+// FocusPrevious moves the focus to the previous focusable component.
+func (gui *GUI) FocusPrevious() {
+	gui.moveFocus(-1)
+}
+
+// Focus focuses the component and unfocuses the previously focused one.
+func (gui *GUI) Focus(c component.Component) {
+	if gui.focusedComponent != nil && gui.focusedComponent != c {
+		gui.focusedComponent.SetFocused(false)
+	}
+
+	c.SetFocused(true)
+	gui.focusedComponent = c
+}
+
+func (gui *GUI) moveFocus(step int) {
+	if gui.rootContainer == nil {
+		return
+	}
+
+	components := gui.focusableComponents()
+	if len(components) == 0 {
+		return
+	}
+
+	current := -1
+	for i, c := range components {
+		if c == gui.focusedComponent {
+			current = i
+			break
+		}
+	}
+
+	var next int
+	switch {
+	case current == -1 && step > 0:
+		next = 0
+	case current == -1:
+		next = len(components) - 1
+	default:
+		next = (current + step + len(components)) % len(components)
+	}
+
+	gui.Focus(components[next])
+}
+
+// handleFocusKeys moves the focus on Tab and Shift+Tab and activates the focused component on Enter and Space.
+// Space doesn't activate the components taking text input, as it's typed into them. It returns whether any of the keys was handled.
+func (gui *GUI) handleFocusKeys() bool {
+	if gui.input.KeyJustPressed(ebiten.KeyTab) {
+		if gui.input.KeyPressed(ebiten.KeyShift) {
+			gui.FocusPrevious()
+		} else {
+			gui.FocusNext()
+		}
+
+		return true
+	}
+
+	if gui.focusedComponent == nil || gui.focusedComponent.Disable() || gui.focusedComponent.Hidden() {
+		return false
+	}
+
+	if gui.input.KeyJustPressed(ebiten.KeyEnter) || gui.input.KeyJustPressed(ebiten.KeySpace) && !gui.textInputFocused() {
+		if a, ok := gui.focusedComponent.(activatable); ok {
+			a.Activate()
+			return true
+		}
+	}
+
+	return false
+}
+
+// focusOrder returns the focusable components of the container trees in the order they are focused.
+// Components with a positive tab index come first, in ascending order, followed by the components
+// with a zero tab index in layout order. Hidden and disabled components and components with a negative tab index are skipped.
+func focusOrder(roots ...component.Component) []component.Component {
+	var components []component.Component
+	for _, root := range roots {
+		components = appendFocusable(components, root)
+	}
+
+	sort.SliceStable(components, func(i, j int) bool {
+		a, b := components[i].TabIndex(), components[j].TabIndex()
+		if a > 0 && b > 0 {
+			return a < b
+		}
+
+		return a > 0 && b == 0
+	})
+
+	return components
+}
+
+func appendFocusable(components []component.Component, c component.Component) []component.Component {
+	if c.Hidden() || c.Disable() {
+		return components
+	}
+
+	if container, ok := c.(componentsContainer); ok {
+		for _, child := range container.Components() {
+			components = appendFocusable(components, child)
+		}
+
+		return components
+	}
+
+	if c.Focusable() && c.TabIndex() >= 0 {
+		components = append(components, c)
+	}
+
+	return components
+}
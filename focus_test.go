@@ -0,0 +1,236 @@
+package chopstiqs
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func newTestGUI(t *testing.T, components ...component.Component) (*GUI, *input.FakeInputSource) {
+	t.Helper()
+
+	return newTestGUIWithOptions(t, &GUIOptions{}, components...)
+}
+
+func newTestGUIWithOptions(t *testing.T, opt *GUIOptions, components ...component.Component) (*GUI, *input.FakeInputSource) {
+	t.Helper()
+
+	in := input.NewFakeInputSource()
+	opt.Input = in
+	gui := NewGUI(opt)
+
+	root := gui.NewContainer(&component.ContainerOptions{
+		Layout: &component.VerticalListLayout{RowGap: 5},
+	})
+	gui.SetRootContainer(root)
+	root.AddComponents(components...)
+
+	return gui, in
+}
+
+func step(t *testing.T, gui *GUI) {
+	t.Helper()
+
+	gui.Update()
+	gui.Draw(ebiten.NewImage(200, 200))
+}
+
+func pressKey(t *testing.T, gui *GUI, in *input.FakeInputSource, key ebiten.Key, modifiers ...ebiten.Key) {
+	t.Helper()
+
+	for _, modifier := range modifiers {
+		in.PressKey(modifier)
+	}
+	in.PressKey(key)
+	step(t, gui)
+
+	in.ReleaseAll()
+	step(t, gui)
+}
+
+func Test_focusOrder(t *testing.T) {
+	newButton := func(tabIndex int) *component.Button {
+		b := component.NewButton(nil)
+		b.SetTabIndex(tabIndex)
+		return b
+	}
+
+	b1, b2, b3 := newButton(0), newButton(0), newButton(0)
+	hidden := newButton(0)
+	hidden.SetHidden(true)
+	disabled := newButton(0)
+	disabled.SetDisabled(true)
+	skipped := newButton(-1)
+	first, second := newButton(1), newButton(2)
+
+	nested := component.NewContainer(nil)
+	nested.AddComponents(b2, component.NewLabel("label", nil))
+
+	hiddenNested := component.NewContainer(nil)
+	hiddenNested.AddComponent(newButton(0))
+	hiddenNested.SetHidden(true)
+
+	tests := []struct {
+		name       string
+		components []component.Component
+		want       []component.Component
+	}{
+		{
+			name:       "layout order",
+			components: []component.Component{b1, b2, b3},
+			want:       []component.Component{b1, b2, b3},
+		},
+		{
+			name:       "nested containers",
+			components: []component.Component{b1, nested, b3},
+			want:       []component.Component{b1, b2, b3},
+		},
+		{
+			name:       "hidden, disabled and negative tab index skipped",
+			components: []component.Component{b1, hidden, disabled, skipped, hiddenNested, b3},
+			want:       []component.Component{b1, b3},
+		},
+		{
+			name:       "positive tab index first",
+			components: []component.Component{b1, second, b3, first},
+			want:       []component.Component{first, second, b1, b3},
+		},
+		{
+			name:       "labels skipped",
+			components: []component.Component{component.NewLabel("label", nil), b1},
+			want:       []component.Component{b1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			root := component.NewContainer(nil)
+			root.AddComponents(tt.components...)
+
+			is.Equal(focusOrder(root), tt.want)
+		})
+	}
+}
+
+func TestGUI_TabFocusTraversal(t *testing.T) {
+	is := is.New(t)
+
+	b1 := component.NewButton(nil)
+	b2 := component.NewButton(nil)
+	ti := component.NewTextInput(nil)
+
+	gui, in := newTestGUI(t, b1, b2, ti)
+	step(t, gui)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), b1)
+	is.True(b1.Focused())
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), b2)
+	is.True(!b1.Focused())
+	is.True(b2.Focused())
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), ti)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), b1)
+
+	pressKey(t, gui, in, ebiten.KeyTab, ebiten.KeyShiftLeft)
+	is.Equal(gui.FocusedComponent(), ti)
+
+	pressKey(t, gui, in, ebiten.KeyTab, ebiten.KeyShiftLeft)
+	is.Equal(gui.FocusedComponent(), b2)
+	is.True(!ti.Focused())
+}
+
+func TestGUI_TabFocusTraversal_fromClickedComponent(t *testing.T) {
+	is := is.New(t)
+
+	b1 := component.NewButton(nil)
+	b2 := component.NewButton(nil)
+
+	gui, in := newTestGUI(t, b1, b2)
+	step(t, gui)
+
+	x, y := b2.AbsPosition()
+	in.MoveCursor(int(x)+1, int(y)+1)
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+	is.Equal(gui.FocusedComponent(), b2)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), b1)
+}
+
+func TestGUI_ActivateFocusedComponent(t *testing.T) {
+	is := is.New(t)
+
+	clicked := 0
+
+	b := component.NewButton(nil)
+	b.AddClickedHandler(func(args *component.ButtonClickedEventArgs) {
+		clicked++
+	})
+
+	cb := component.NewCheckBox(nil)
+
+	gui, in := newTestGUI(t, b, cb)
+	step(t, gui)
+
+	pressKey(t, gui, in, ebiten.KeyEnter)
+	is.Equal(clicked, 0)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	pressKey(t, gui, in, ebiten.KeyEnter)
+	is.Equal(clicked, 1)
+
+	pressKey(t, gui, in, ebiten.KeySpace)
+	is.Equal(clicked, 2)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	pressKey(t, gui, in, ebiten.KeySpace)
+	is.True(cb.Checked())
+
+	pressKey(t, gui, in, ebiten.KeyEnter)
+	is.True(!cb.Checked())
+
+	b.SetDisabled(true)
+	gui.Focus(b)
+	pressKey(t, gui, in, ebiten.KeyEnter)
+	is.Equal(clicked, 2)
+}
+
+func TestGUI_TabFocusTraversal_scrollContainer(t *testing.T) {
+	is := is.New(t)
+
+	sc := component.NewScrollContainer(&component.ScrollContainerOptions{
+		Layout: &component.VerticalListLayout{RowGap: 5},
+		Height: option.Int(20),
+	})
+
+	b1 := component.NewButton(nil)
+	b2 := component.NewButton(nil)
+	sc.AddComponents(b1, b2)
+
+	gui, in := newTestGUI(t, sc)
+	step(t, gui)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), b1)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), b2)
+
+	_, scrollY := sc.ScrollPosition()
+	is.Equal(scrollY, 20+15-20) // scrolled to the bottom edge of the focused button
+}
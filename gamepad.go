@@ -0,0 +1,207 @@
+package chopstiqs
+
+import (
+	"image"
+
+	"github.com/fglo/chopstiqs/component"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// gamepadStickThreshold is the left stick axis value past which the stick moves the focus, like a D-pad button.
+const gamepadStickThreshold = 0.5
+
+// adjustable is implemented by components whose value can be adjusted with the gamepad when focused, like sliders.
+type adjustable interface {
+	Increment()
+	Decrement()
+}
+
+// direction is a direction of the spatial navigation.
+type direction int
+
+const (
+	directionNone direction = iota
+	directionUp
+	directionDown
+	directionLeft
+	directionRight
+)
+
+// Unfocus unfocuses the focused component.
+func (gui *GUI) Unfocus() {
+	if gui.focusedComponent != nil {
+		gui.focusedComponent.SetFocused(false)
+		gui.focusedComponent = nil
+	}
+}
+
+// handleGamepad moves the focus spatially with the D-pad and the left stick, activates the focused component with A,
+// unfocuses it with B and adjusts it with the shoulder buttons.
+func (gui *GUI) handleGamepad() {
+	if dir := gui.gamepadDirection(); dir != directionNone {
+		gui.moveFocusInDirection(dir)
+		return
+	}
+
+	if gui.focusedComponent == nil {
+		return
+	}
+
+	if gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonRightRight) {
+		gui.Unfocus()
+		return
+	}
+
+	if gui.focusedComponent.Disable() || gui.focusedComponent.Hidden() {
+		return
+	}
+
+	if gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonRightBottom) {
+		if a, ok := gui.focusedComponent.(activatable); ok {
+			a.Activate()
+		}
+	}
+
+	if a, ok := gui.focusedComponent.(adjustable); ok {
+		if gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonFrontTopLeft) {
+			a.Decrement()
+		}
+
+		if gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonFrontTopRight) {
+			a.Increment()
+		}
+	}
+}
+
+// gamepadDirection returns the direction the D-pad was pressed in or the left stick was pushed in during the current update.
+func (gui *GUI) gamepadDirection() direction {
+	stickDirection := directionNone
+
+	x := gui.input.GamepadAxis(ebiten.StandardGamepadAxisLeftStickHorizontal)
+	y := gui.input.GamepadAxis(ebiten.StandardGamepadAxisLeftStickVertical)
+
+	switch {
+	case y <= -gamepadStickThreshold:
+		stickDirection = directionUp
+	case y >= gamepadStickThreshold:
+		stickDirection = directionDown
+	case x <= -gamepadStickThreshold:
+		stickDirection = directionLeft
+	case x >= gamepadStickThreshold:
+		stickDirection = directionRight
+	}
+
+	stickMoved := stickDirection != gui.lastStickDirection
+	gui.lastStickDirection = stickDirection
+
+	switch {
+	case gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonLeftTop):
+		return directionUp
+	case gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonLeftBottom):
+		return directionDown
+	case gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonLeftLeft):
+		return directionLeft
+	case gui.input.GamepadButtonJustPressed(ebiten.StandardGamepadButtonLeftRight):
+		return directionRight
+	case stickMoved:
+		return stickDirection
+	}
+
+	return directionNone
+}
+
+// moveFocusInDirection moves the focus to the nearest focusable component in the direction.
+// If no component is focused, the first focusable component is focused.
+func (gui *GUI) moveFocusInDirection(dir direction) {
+	if gui.rootContainer == nil {
+		return
+	}
+
+	if gui.focusedComponent == nil {
+		gui.FocusNext()
+		return
+	}
+
+	if next := nearestInDirection(gui.focusableComponents(), gui.focusedComponent, dir); next != nil {
+		gui.Focus(next)
+	}
+}
+
+// nearestInDirection returns the component nearest to the from component in the direction, or nil if there is none.
+// Components overlapping the from component along the direction's axis are preferred over the ones that are further off it.
+func nearestInDirection(components []component.Component, from component.Component, dir direction) component.Component {
+	fromRect := bounds(from)
+
+	var nearest component.Component
+	nearestScore := 0
+
+	for _, c := range components {
+		if c == from {
+			continue
+		}
+
+		rect := bounds(c)
+
+		var distance, offset int
+		switch dir {
+		case directionUp:
+			if center(rect).Y >= center(fromRect).Y {
+				continue
+			}
+			distance = fromRect.Min.Y - rect.Max.Y
+			offset = gap(rect.Min.X, rect.Max.X, fromRect.Min.X, fromRect.Max.X)
+		case directionDown:
+			if center(rect).Y <= center(fromRect).Y {
+				continue
+			}
+			distance = rect.Min.Y - fromRect.Max.Y
+			offset = gap(rect.Min.X, rect.Max.X, fromRect.Min.X, fromRect.Max.X)
+		case directionLeft:
+			if center(rect).X >= center(fromRect).X {
+				continue
+			}
+			distance = fromRect.Min.X - rect.Max.X
+			offset = gap(rect.Min.Y, rect.Max.Y, fromRect.Min.Y, fromRect.Max.Y)
+		case directionRight:
+			if center(rect).X <= center(fromRect).X {
+				continue
+			}
+			distance = rect.Min.X - fromRect.Max.X
+			offset = gap(rect.Min.Y, rect.Max.Y, fromRect.Min.Y, fromRect.Max.Y)
+		default:
+			return nil
+		}
+
+		score := max(distance, 0) + 2*offset
+		if nearest == nil || score < nearestScore {
+			nearest = c
+			nearestScore = score
+		}
+	}
+
+	return nearest
+}
+
+// bounds returns the component's bounds in the gui coordinates.
+func bounds(c component.Component) image.Rectangle {
+	x, y := c.AbsPosition()
+	w, h := c.Dimensions()
+
+	return image.Rect(int(x), int(y), int(x)+w, int(y)+h)
+}
+
+func center(rect image.Rectangle) image.Point {
+	return image.Pt((rect.Min.X+rect.Max.X)/2, (rect.Min.Y+rect.Max.Y)/2)
+}
+
+// gap returns the distance between two ranges, or 0 if they overlap.
+func gap(aMin, aMax, bMin, bMax int) int {
+	switch {
+	case aMax <= bMin:
+		return bMin - aMax
+	case bMax <= aMin:
+		return aMin - bMax
+	}
+
+	return 0
+}
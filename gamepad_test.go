@@ -0,0 +1,181 @@
+package chopstiqs
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func pressGamepadButton(t *testing.T, gui *GUI, in *input.FakeInputSource, button ebiten.StandardGamepadButton) {
+	t.Helper()
+
+	in.PressGamepadButton(button)
+	step(t, gui)
+
+	in.ReleaseGamepadButton(button)
+	step(t, gui)
+}
+
+// newTestGrid creates a gui with a 2x2 grid of buttons:
+//
+//	topLeft    topRight
+//	bottomLeft bottomRight
+func newTestGrid(t *testing.T) (*GUI, *input.FakeInputSource, [2][2]*component.Button) {
+	t.Helper()
+
+	var buttons [2][2]*component.Button
+	var rows []component.Component
+
+	for i := range buttons {
+		row := component.NewContainer(&component.ContainerOptions{
+			Layout: &component.HorizontalListLayout{ColumnGap: 5},
+		})
+
+		for j := range buttons[i] {
+			buttons[i][j] = component.NewButton(nil)
+			row.AddComponent(buttons[i][j])
+		}
+
+		rows = append(rows, row)
+	}
+
+	gui, in := newTestGUIWithOptions(t, &GUIOptions{GamepadNavigation: true}, rows...)
+	step(t, gui)
+
+	return gui, in, buttons
+}
+
+func TestGUI_GamepadNavigation_DPad(t *testing.T) {
+	is := is.New(t)
+
+	gui, in, buttons := newTestGrid(t)
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonLeftRight)
+	is.Equal(gui.FocusedComponent(), buttons[0][0])
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonLeftRight)
+	is.Equal(gui.FocusedComponent(), buttons[0][1])
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonLeftRight)
+	is.Equal(gui.FocusedComponent(), buttons[0][1])
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonLeftBottom)
+	is.Equal(gui.FocusedComponent(), buttons[1][1])
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonLeftLeft)
+	is.Equal(gui.FocusedComponent(), buttons[1][0])
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonLeftTop)
+	is.Equal(gui.FocusedComponent(), buttons[0][0])
+	is.True(buttons[0][0].Focused())
+	is.True(!buttons[1][0].Focused())
+}
+
+func TestGUI_GamepadNavigation_LeftStick(t *testing.T) {
+	is := is.New(t)
+
+	gui, in, buttons := newTestGrid(t)
+	gui.Focus(buttons[0][0])
+
+	in.MoveGamepadAxis(ebiten.StandardGamepadAxisLeftStickHorizontal, 0.9)
+	step(t, gui)
+	is.Equal(gui.FocusedComponent(), buttons[0][1])
+
+	// holding the stick doesn't move the focus any further
+	step(t, gui)
+	is.Equal(gui.FocusedComponent(), buttons[0][1])
+
+	in.MoveGamepadAxis(ebiten.StandardGamepadAxisLeftStickHorizontal, 0.1)
+	step(t, gui)
+	in.MoveGamepadAxis(ebiten.StandardGamepadAxisLeftStickVertical, 1)
+	step(t, gui)
+	is.Equal(gui.FocusedComponent(), buttons[1][1])
+}
+
+func TestGUI_GamepadNavigation_Disabled(t *testing.T) {
+	is := is.New(t)
+
+	b := component.NewButton(nil)
+
+	gui, in := newTestGUI(t, b)
+	step(t, gui)
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonLeftBottom)
+	is.Equal(gui.FocusedComponent(), nil)
+}
+
+func TestGUI_GamepadNavigation_ActivateAndUnfocus(t *testing.T) {
+	is := is.New(t)
+
+	clicked := 0
+
+	b := component.NewButton(nil)
+	b.AddClickedHandler(func(args *component.ButtonClickedEventArgs) {
+		clicked++
+	})
+
+	gui, in := newTestGUIWithOptions(t, &GUIOptions{GamepadNavigation: true}, b)
+	step(t, gui)
+
+	gui.Focus(b)
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonRightBottom)
+	is.Equal(clicked, 1)
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonRightRight)
+	is.Equal(gui.FocusedComponent(), nil)
+	is.True(!b.Focused())
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonRightBottom)
+	is.Equal(clicked, 1)
+}
+
+func TestGUI_GamepadNavigation_AdjustSlider(t *testing.T) {
+	is := is.New(t)
+
+	s := component.NewSlider(&component.SliderOptions{
+		Min:          option.Float(0),
+		Max:          option.Float(10),
+		Step:         option.Float(2),
+		DefaultValue: option.Float(4),
+	})
+
+	gui, in := newTestGUIWithOptions(t, &GUIOptions{GamepadNavigation: true}, s)
+	step(t, gui)
+	gui.Focus(s)
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonFrontTopRight)
+	is.Equal(s.GetValue(), float64(6))
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonFrontTopLeft)
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonFrontTopLeft)
+	is.Equal(s.GetValue(), float64(2))
+
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonFrontTopLeft)
+	pressGamepadButton(t, gui, in, ebiten.StandardGamepadButtonFrontTopLeft)
+	is.Equal(s.GetValue(), float64(0))
+}
+
+func Test_nearestInDirection_prefersAlignedComponents(t *testing.T) {
+	is := is.New(t)
+
+	newButtonAt := func(x, y float64) *component.Button {
+		b := component.NewButton(&component.ButtonOptions{Width: option.Int(10), Height: option.Int(10)})
+		b.SetPosition(x, y)
+		return b
+	}
+
+	from := newButtonAt(0, 0)
+	aligned := newButtonAt(50, 0)
+	closerButOff := newButtonAt(20, 40)
+
+	components := []component.Component{from, aligned, closerButOff}
+
+	is.Equal(nearestInDirection(components, from, directionRight), aligned)
+	is.Equal(nearestInDirection(components, from, directionDown), closerButOff)
+	is.Equal(nearestInDirection(components, from, directionLeft), nil)
+	is.Equal(nearestInDirection(components, from, directionUp), nil)
+}
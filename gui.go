@@ -7,6 +7,8 @@ import (
 	"github.com/fglo/chopstiqs/event"
 	"github.com/fglo/chopstiqs/input"
 	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/fglo/chopstiqs/tween"
 	ebiten "github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -15,8 +17,32 @@ type GUI struct {
 	rootContainer *component.Container
 	// eventManager is a queue of events by GUI components
 	eventManager *event.Manager
+	// scheduler calls the components' delayed and repeated actions. It's ticked once per update.
+	scheduler *timer.Scheduler
+	// animator plays the animations started with Animate. It's driven by the scheduler.
+	animator *tween.Animator
+	// layers hold the components drawn above the root container, indexed by Layer. The base layer is the root container.
+	layers [layerCount]*component.Container
+	// input is the source of the input state passed down to the components
+	input input.InputSource
 
 	focusedComponent component.Component
+	// tooltip is the tooltip shown on the tooltip layer
+	tooltip *component.Tooltip
+	// popups are the popups opened by the components, in the order they were opened
+	popups []openPopup
+
+	// gamepadNavigation enables moving the focus with a gamepad
+	gamepadNavigation bool
+	// lastStickDirection is the direction the gamepad's left stick was pushed in during the previous update
+	lastStickDirection direction
+
+	cursorOverGUI    bool
+	mouseConsumed    bool
+	keyboardConsumed bool
+	// mouseCaptured is set when a mouse button is pressed over the gui and cleared when all buttons are released,
+	// so dragging from the gui to the outside is consumed as well
+	mouseCaptured bool
 
 	horizontalAlignment option.HorizontalAlignment
 	verticalAlignment   option.VerticalAlignment
@@ -25,6 +51,16 @@ type GUI struct {
 type GUIOptions struct {
 	HorizontalAlignment option.HorizontalAlignment
 	VerticalAlignment   option.VerticalAlignment
+
+	// Input is the source of the input state. If not set, the input is polled from ebiten.
+	Input input.InputSource
+
+	// Clock is the source of the time for the scheduler's duration based timers. If not set, the system clock is used.
+	Clock timer.Clock
+
+	// GamepadNavigation enables moving the focus between components with the gamepad's D-pad or left stick.
+	// The focused component is activated with A, unfocused with B and adjusted with the shoulder buttons.
+	GamepadNavigation bool
 }
 
 func NewGUI(opt *GUIOptions) *GUI {
@@ -32,9 +68,26 @@ func NewGUI(opt *GUIOptions) *GUI {
 		eventManager: event.NewManager(),
 	}
 
+	var clock timer.Clock
+
 	if opt != nil {
 		gui.horizontalAlignment = opt.HorizontalAlignment
 		gui.verticalAlignment = opt.VerticalAlignment
+		gui.input = opt.Input
+		gui.gamepadNavigation = opt.GamepadNavigation
+		clock = opt.Clock
+	}
+
+	if gui.input == nil {
+		gui.input = input.NewEbitenInputSource()
+	}
+
+	gui.scheduler = timer.NewScheduler(clock)
+	gui.eventManager.SetScheduler(gui.scheduler)
+	gui.animator = tween.NewAnimator(gui.scheduler, gui.eventManager)
+
+	for layer := LayerBase + 1; layer < layerCount; layer++ {
+		gui.layers[layer] = gui.newLayerContainer()
 	}
 
 	return gui
@@ -43,30 +96,64 @@ func NewGUI(opt *GUIOptions) *GUI {
 // SetRootContainer sets the gui root container.
 func (gui *GUI) SetRootContainer(container *component.Container) {
 	container.SetEventManager(gui.eventManager)
+	container.SetAnimator(gui.animator)
 	gui.rootContainer = container
 	gui.rootContainer.AddFocusedHandler(gui.handleFocusEvent)
+	gui.rootContainer.AddTooltipHandler(gui.handleTooltipEvent)
+	gui.rootContainer.AddPopupHandler(gui.handlePopupEvent)
 }
 
 // Update updates containers.
 // It should be called in the Ebiten Game's Update function.
 func (gui *GUI) Update() {
-	input.Update()
-	gui.rootContainer.FireEvents()
+	gui.input.Update()
+	gui.scheduler.Tick()
+	gui.fireEvents()
+	focusKeysHandled := gui.handleFocusKeys()
+
+	if gui.gamepadNavigation {
+		gui.handleGamepad()
+	}
+
+	gui.updateConsumedInput(focusKeysHandled)
 }
 
 // Draw draws containers to the guiImage.
 // It should be called in the Ebiten Game's Draw function.
 func (gui *GUI) Draw(guiImage *ebiten.Image) {
-	input.Draw()
-	defer input.AfterDraw()
-
 	gui.eventManager.HandleFired()
 
 	gui.alignRootContainerInBounds(guiImage.Bounds())
 
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(gui.rootContainer.Position())
+	op.ColorScale.ScaleAlpha(float32(gui.rootContainer.Alpha()))
 	guiImage.DrawImage(gui.rootContainer.Draw(), op)
+
+	gui.drawLayers(guiImage)
+}
+
+// Scheduler returns the gui's scheduler. It's ticked once per Update, so the functions scheduled with it
+// are called on the game loop's goroutine.
+func (gui *GUI) Scheduler() *timer.Scheduler {
+	return gui.scheduler
+}
+
+// Animate starts playing the animation. It's advanced on every Update by the time passed since the previous one.
+// The animation's finished handlers are called on Draw, like the handlers of the components' events.
+func (gui *GUI) Animate(animation tween.Animation) {
+	gui.animator.Play(animation)
+}
+
+// Animator returns the animator playing the animations started with Animate and the components' animations, like the toggle switches' knobs.
+func (gui *GUI) Animator() *tween.Animator {
+	return gui.animator
+}
+
+// RunOnUIThread queues the function to be called on the game loop's goroutine by the next Draw, before the components' events are handled.
+// It's safe to call from any goroutine, so background work can update the components when it finishes.
+func (gui *GUI) RunOnUIThread(f func()) {
+	gui.eventManager.Post(f)
 }
 
 func (gui *GUI) NewContainer(options *component.ContainerOptions) *component.Container {
@@ -75,6 +162,12 @@ func (gui *GUI) NewContainer(options *component.ContainerOptions) *component.Con
 	return c
 }
 
+func (gui *GUI) NewScrollContainer(options *component.ScrollContainerOptions) *component.ScrollContainer {
+	sc := component.NewScrollContainer(options)
+	sc.SetEventManager(gui.eventManager)
+	return sc
+}
+
 func (gui *GUI) NewButton(options *component.ButtonOptions) *component.Button {
 	b := component.NewButton(options)
 	b.SetEventManager(gui.eventManager)
@@ -87,6 +180,24 @@ func (gui *GUI) NewCheckBox(options *component.CheckBoxOptions) *component.Check
 	return cb
 }
 
+func (gui *GUI) NewToggleSwitch(options *component.ToggleSwitchOptions) *component.ToggleSwitch {
+	sw := component.NewToggleSwitch(options)
+	sw.SetEventManager(gui.eventManager)
+	return sw
+}
+
+func (gui *GUI) NewToggleButton(options *component.ToggleButtonOptions) *component.ToggleButton {
+	tb := component.NewToggleButton(options)
+	tb.SetEventManager(gui.eventManager)
+	return tb
+}
+
+func (gui *GUI) NewRadioButton(options *component.RadioButtonOptions) *component.RadioButton {
+	rb := component.NewRadioButton(options)
+	rb.SetEventManager(gui.eventManager)
+	return rb
+}
+
 func (gui *GUI) NewLabel(labelText string, options *component.LabelOptions) *component.Label {
 	l := component.NewLabel(labelText, options)
 	l.SetEventManager(gui.eventManager)
@@ -99,11 +210,60 @@ func (gui *GUI) NewSlider(options *component.SliderOptions) *component.Slider {
 	return s
 }
 
+func (gui *GUI) NewProgressBar(options *component.ProgressBarOptions) *component.ProgressBar {
+	pb := component.NewProgressBar(options)
+	pb.SetEventManager(gui.eventManager)
+	return pb
+}
+
+func (gui *GUI) NewComboBox(options *component.ComboBoxOptions) *component.ComboBox {
+	cb := component.NewComboBox(options)
+	cb.SetEventManager(gui.eventManager)
+	return cb
+}
+
+func (gui *GUI) NewDialog(options *component.DialogOptions) *component.Dialog {
+	d := component.NewDialog(options)
+	d.SetEventManager(gui.eventManager)
+	return d
+}
+
+// Input returns the gui input source.
+func (gui *GUI) Input() input.InputSource {
+	return gui.input
+}
+
+// CursorOverGUI returns whether the mouse cursor is over any of the gui components in the current update.
+// Containers are taken into account only if their background is visible.
+func (gui *GUI) CursorOverGUI() bool {
+	return gui.cursorOverGUI
+}
+
+// MouseConsumed returns whether the mouse input of the current update was handled by the gui,
+// so the game shouldn't handle it as well. It's the case when the wheel is scrolled over the gui
+// or a mouse button was pressed over the gui and isn't released yet, even if the cursor left the gui since,
+// and while a dialog is open.
+func (gui *GUI) MouseConsumed() bool {
+	return gui.mouseConsumed
+}
+
+// KeyboardConsumed returns whether the keyboard input of the current update was handled by the gui,
+// so the game shouldn't handle it as well. It's the case when a text input is focused
+// or the keys were used to move the focus or to activate the focused component, and while a dialog is open.
+func (gui *GUI) KeyboardConsumed() bool {
+	return gui.keyboardConsumed
+}
+
 func (gui *GUI) FocusedComponent() component.Component {
 	return gui.focusedComponent
 }
 
 func (gui *GUI) handleFocusEvent(args *component.ComponentFocusedEventArgs) {
+	// focus events are deferred, so the component's focus may have changed again since the event was fired
+	if args.Focused != args.Component.Focused() {
+		return
+	}
+
 	if args.Focused {
 		if gui.focusedComponent != nil && gui.focusedComponent != args.Component {
 			gui.focusedComponent.SetFocused(false)
@@ -115,6 +275,74 @@ func (gui *GUI) handleFocusEvent(args *component.ComponentFocusedEventArgs) {
 	}
 }
 
+func (gui *GUI) updateConsumedInput(focusKeysHandled bool) {
+	dialogOpen := gui.topDialog() != nil
+
+	gui.cursorOverGUI = dialogOpen || cursorOver(gui.rootContainer)
+	for layer := LayerBase + 1; layer < LayerTooltip; layer++ {
+		gui.cursorOverGUI = gui.cursorOverGUI || cursorOver(gui.layers[layer])
+	}
+
+	anyMouseButtonPressed := false
+	anyMouseButtonJustPressed := false
+	for b := ebiten.MouseButton(0); b <= ebiten.MouseButtonMax; b++ {
+		anyMouseButtonPressed = anyMouseButtonPressed || gui.input.MouseButtonPressed(b)
+		anyMouseButtonJustPressed = anyMouseButtonJustPressed || gui.input.MouseButtonJustPressed(b)
+	}
+
+	if gui.cursorOverGUI && anyMouseButtonJustPressed {
+		gui.mouseCaptured = true
+	}
+
+	wheelX, wheelY := gui.input.Wheel()
+	wheelScrolled := wheelX != 0 || wheelY != 0
+
+	gui.mouseConsumed = dialogOpen || gui.mouseCaptured || gui.cursorOverGUI && wheelScrolled
+
+	if !anyMouseButtonPressed {
+		gui.mouseCaptured = false
+	}
+
+	gui.keyboardConsumed = dialogOpen || focusKeysHandled || gui.textInputFocused()
+}
+
+// textInputFocused returns whether the focused component takes text input.
+func (gui *GUI) textInputFocused() bool {
+	if gui.focusedComponent == nil || gui.focusedComponent.Disable() || gui.focusedComponent.Hidden() {
+		return false
+	}
+
+	switch c := gui.focusedComponent.(type) {
+	case *component.TextInput, *component.TextArea:
+		return true
+	case *component.ComboBox:
+		return c.Editable()
+	}
+
+	return false
+}
+
+// cursorOver returns whether the mouse cursor is over the component or any of its visible components.
+func cursorOver(c component.Component) bool {
+	if c.Hidden() {
+		return false
+	}
+
+	if c.CursorOver() {
+		return true
+	}
+
+	if container, ok := c.(componentsContainer); ok {
+		for _, child := range container.Components() {
+			if cursorOver(child) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (gui *GUI) alignRootContainerInBounds(bounds image.Rectangle) {
 	w := gui.rootContainer.WidthWithPadding()
 	h := gui.rootContainer.HeightWithPadding()
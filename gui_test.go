@@ -3,9 +3,13 @@ package chopstiqs
 import (
 	"image"
 	"testing"
+	"time"
 
 	"github.com/fglo/chopstiqs/component"
 	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/fglo/chopstiqs/tween"
+	"github.com/matryer/is"
 )
 
 func TestGUI_alignRootContainerInBounds(t *testing.T) {
@@ -142,3 +146,60 @@ func TestGUI_alignRootContainerInBounds(t *testing.T) {
 		})
 	}
 }
+
+func TestGUI_RunOnUIThread(t *testing.T) {
+	is := is.New(t)
+
+	b := component.NewButton(nil)
+	b.SetDisabled(true)
+
+	gui, _ := newTestGUI(t, b)
+
+	clicked := 0
+	b.AddClickedHandler(func(args *component.ButtonClickedEventArgs) {
+		clicked++
+	})
+
+	loaded := make(chan struct{})
+	go func() {
+		// e.g. assets loaded in the background
+		gui.RunOnUIThread(func() {
+			b.SetDisabled(false)
+			b.Activate()
+		})
+		close(loaded)
+	}()
+
+	<-loaded
+	is.True(b.Disable()) // not called until the gui is drawn
+
+	step(t, gui)
+	is.True(!b.Disable())
+	is.Equal(clicked, 1) // events fired by the posted function are handled in the same frame
+}
+
+func TestGUI_Animate(t *testing.T) {
+	is := is.New(t)
+
+	clock := timer.NewFakeClock()
+	b := component.NewButton(nil)
+	gui, _ := newTestGUIWithOptions(t, &GUIOptions{Clock: clock}, b)
+
+	finished := false
+	fade := component.FadeTo(b, 0, time.Second, tween.Linear)
+	fade.AddFinishedHandler(func(args *tween.FinishedEventArgs) {
+		finished = true
+	})
+
+	gui.Animate(fade)
+
+	clock.Advance(500 * time.Millisecond)
+	step(t, gui)
+	is.Equal(b.Alpha(), 0.5)
+
+	clock.Advance(500 * time.Millisecond)
+	step(t, gui)
+	is.Equal(b.Alpha(), 0.0)
+	is.True(finished)
+	is.True(!gui.Animator().Playing(fade))
+}
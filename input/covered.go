@@ -0,0 +1,76 @@
+package input
+
+import (
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// HiddenCursorPosition is the cursor position reported by the input sources hiding the mouse cursor.
+// It's far outside of any component, so nothing is hovered or clicked.
+const HiddenCursorPosition = -1 << 20
+
+// CoveredInputSource hides the mouse cursor and the wheel of the wrapped input source,
+// like from the components covered by a component above them. The keyboard and the gamepads are left as they are.
+type CoveredInputSource struct {
+	InputSource
+}
+
+func (in CoveredInputSource) CursorPosition() (int, int) {
+	return HiddenCursorPosition, HiddenCursorPosition
+}
+
+func (in CoveredInputSource) Wheel() (float64, float64) {
+	return 0, 0
+}
+
+// BlockedInputSource hides all input of the wrapped input source, like from the components below an open dialog.
+type BlockedInputSource struct {
+	InputSource
+}
+
+func (in BlockedInputSource) CursorPosition() (int, int) {
+	return HiddenCursorPosition, HiddenCursorPosition
+}
+
+func (in BlockedInputSource) MouseButtonPressed(button ebiten.MouseButton) bool {
+	return false
+}
+
+func (in BlockedInputSource) MouseButtonJustPressed(button ebiten.MouseButton) bool {
+	return false
+}
+
+func (in BlockedInputSource) KeyPressed(key ebiten.Key) bool {
+	return false
+}
+
+func (in BlockedInputSource) KeyJustPressed(key ebiten.Key) bool {
+	return false
+}
+
+func (in BlockedInputSource) AnyKeyPressed() bool {
+	return false
+}
+
+func (in BlockedInputSource) InputChars() []rune {
+	return nil
+}
+
+func (in BlockedInputSource) Wheel() (float64, float64) {
+	return 0, 0
+}
+
+func (in BlockedInputSource) Touches() []Touch {
+	return nil
+}
+
+func (in BlockedInputSource) GamepadButtonPressed(button ebiten.StandardGamepadButton) bool {
+	return false
+}
+
+func (in BlockedInputSource) GamepadButtonJustPressed(button ebiten.StandardGamepadButton) bool {
+	return false
+}
+
+func (in BlockedInputSource) GamepadAxis(axis ebiten.StandardGamepadAxis) float64 {
+	return 0
+}
@@ -10,27 +10,26 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
-func DetectSystem() {
+func detectSystem() OperatingSystem {
 	switch runtime.GOOS {
 	case "windows":
-		OS = Windows
+		return Windows
 	case "linux":
-		OS = Linux
+		return Linux
 	case "darwin":
-		OS = MacOS
+		return MacOS
 	}
+
+	return ""
 }
 
-func DetectPressedKeys() {
+func (s *EbitenInputSource) detectPressedKeys() {
 	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
-		KeyPressed[k] = ebiten.IsKeyPressed(k)
-		if KeyPressed[k] {
-			AnyKeyPressed = true
+		s.keyPressed[k] = ebiten.IsKeyPressed(k)
+		if s.keyPressed[k] {
+			s.anyKeyPressed = true
 		}
 
-		KeyJustPressed[k] = inpututil.IsKeyJustPressed(k)
-		if KeyJustPressed[k] {
-			AnyJustKeyPressed = true
-		}
+		s.keyJustPressed[k] = inpututil.IsKeyJustPressed(k)
 	}
 }
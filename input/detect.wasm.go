@@ -12,48 +12,37 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
-var (
-	lastPressedKeys [ebiten.KeyMax + 1]bool
-)
-
-func init() {}
-
-func DetectSystem() {
+func detectSystem() OperatingSystem {
 	switch runtime.GOOS {
 	case "windows":
-		OS = Windows
+		return Windows
 	case "linux":
-		OS = Linux
+		return Linux
 	case "darwin":
-		OS = MacOS
+		return MacOS
 	case "js":
 		platform := strings.ToLower(js.Global().Get("navigator").Get("platform").String())
 		switch {
 		case strings.Contains(platform, "mac"):
-			OS = MacOS
+			return MacOS
 		case strings.Contains(platform, "win"):
-			OS = Windows
+			return Windows
 		case strings.Contains(platform, "linux"):
-			OS = Linux
+			return Linux
 		}
 	}
-}
 
-func DetectPressedKeys() {
-	for k, p := range KeyPressed {
-		lastPressedKeys[k] = p
-	}
+	return ""
+}
 
+func (s *EbitenInputSource) detectPressedKeys() {
 	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
-		KeyPressed[k] = ebiten.IsKeyPressed(k)
-		if KeyPressed[k] {
-			AnyKeyPressed = true
+		s.keyPressed[k] = ebiten.IsKeyPressed(k)
+		if s.keyPressed[k] {
+			s.anyKeyPressed = true
 		}
 
-		KeyJustPressed[k] = inpututil.IsKeyJustPressed(k)
-		if KeyJustPressed[k] {
-			AnyJustKeyPressed = true
-		}
+		s.keyJustPressed[k] = inpututil.IsKeyJustPressed(k)
 	}
 }
 
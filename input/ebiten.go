@@ -0,0 +1,158 @@
+package input
+
+import (
+	"math"
+
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// EbitenInputSource is an InputSource that polls the input state from ebiten.
+type EbitenInputSource struct {
+	os OperatingSystem
+
+	cursorPosX int
+	cursorPosY int
+
+	mouseButtonPressed     [ebiten.MouseButtonMax + 1]bool
+	mouseButtonJustPressed [ebiten.MouseButtonMax + 1]bool
+
+	anyKeyPressed  bool
+	keyPressed     [ebiten.KeyMax + 1]bool
+	keyJustPressed [ebiten.KeyMax + 1]bool
+
+	inputChars []rune
+
+	wheelX float64
+	wheelY float64
+
+	touches []Touch
+
+	gamepadIDs               []ebiten.GamepadID
+	gamepadButtonPressed     [ebiten.StandardGamepadButtonMax + 1]bool
+	gamepadButtonJustPressed [ebiten.StandardGamepadButtonMax + 1]bool
+	gamepadAxes              [ebiten.StandardGamepadAxisMax + 1]float64
+}
+
+// NewEbitenInputSource creates a new input source backed by ebiten.
+func NewEbitenInputSource() *EbitenInputSource {
+	return &EbitenInputSource{
+		os: detectSystem(),
+	}
+}
+
+// Update polls the current input state from ebiten.
+func (s *EbitenInputSource) Update() {
+	s.cursorPosX, s.cursorPosY = ebiten.CursorPosition()
+
+	for b := ebiten.MouseButton(0); b <= ebiten.MouseButtonMax; b++ {
+		s.mouseButtonPressed[b] = ebiten.IsMouseButtonPressed(b)
+		s.mouseButtonJustPressed[b] = inpututil.IsMouseButtonJustPressed(b)
+	}
+
+	s.inputChars = ebiten.AppendInputChars(nil)
+
+	s.wheelX, s.wheelY = ebiten.Wheel()
+
+	s.touches = s.touches[:0]
+	justPressedTouchIDs := inpututil.AppendJustPressedTouchIDs(nil)
+	for _, id := range ebiten.AppendTouchIDs(nil) {
+		x, y := ebiten.TouchPosition(id)
+		touch := Touch{ID: id, X: x, Y: y}
+
+		for _, justPressedID := range justPressedTouchIDs {
+			if justPressedID == id {
+				touch.JustPressed = true
+				break
+			}
+		}
+
+		s.touches = append(s.touches, touch)
+	}
+
+	s.anyKeyPressed = false
+	s.detectPressedKeys()
+
+	s.pollGamepads()
+}
+
+func (s *EbitenInputSource) pollGamepads() {
+	s.gamepadButtonPressed = [ebiten.StandardGamepadButtonMax + 1]bool{}
+	s.gamepadButtonJustPressed = [ebiten.StandardGamepadButtonMax + 1]bool{}
+	s.gamepadAxes = [ebiten.StandardGamepadAxisMax + 1]float64{}
+
+	s.gamepadIDs = ebiten.AppendGamepadIDs(s.gamepadIDs[:0])
+	for _, id := range s.gamepadIDs {
+		if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+			continue
+		}
+
+		for b := ebiten.StandardGamepadButton(0); b <= ebiten.StandardGamepadButtonMax; b++ {
+			if ebiten.IsStandardGamepadButtonPressed(id, b) {
+				s.gamepadButtonPressed[b] = true
+			}
+
+			if inpututil.IsStandardGamepadButtonJustPressed(id, b) {
+				s.gamepadButtonJustPressed[b] = true
+			}
+		}
+
+		for a := ebiten.StandardGamepadAxis(0); a <= ebiten.StandardGamepadAxisMax; a++ {
+			if v := ebiten.StandardGamepadAxisValue(id, a); math.Abs(v) > math.Abs(s.gamepadAxes[a]) {
+				s.gamepadAxes[a] = v
+			}
+		}
+	}
+}
+
+func (s *EbitenInputSource) OS() OperatingSystem {
+	return s.os
+}
+
+func (s *EbitenInputSource) CursorPosition() (int, int) {
+	return s.cursorPosX, s.cursorPosY
+}
+
+func (s *EbitenInputSource) MouseButtonPressed(button ebiten.MouseButton) bool {
+	return s.mouseButtonPressed[button]
+}
+
+func (s *EbitenInputSource) MouseButtonJustPressed(button ebiten.MouseButton) bool {
+	return s.mouseButtonJustPressed[button]
+}
+
+func (s *EbitenInputSource) KeyPressed(key ebiten.Key) bool {
+	return s.keyPressed[key]
+}
+
+func (s *EbitenInputSource) KeyJustPressed(key ebiten.Key) bool {
+	return s.keyJustPressed[key]
+}
+
+func (s *EbitenInputSource) AnyKeyPressed() bool {
+	return s.anyKeyPressed
+}
+
+func (s *EbitenInputSource) InputChars() []rune {
+	return s.inputChars
+}
+
+func (s *EbitenInputSource) Wheel() (float64, float64) {
+	return s.wheelX, s.wheelY
+}
+
+func (s *EbitenInputSource) Touches() []Touch {
+	return s.touches
+}
+
+func (s *EbitenInputSource) GamepadButtonPressed(button ebiten.StandardGamepadButton) bool {
+	return s.gamepadButtonPressed[button]
+}
+
+func (s *EbitenInputSource) GamepadButtonJustPressed(button ebiten.StandardGamepadButton) bool {
+	return s.gamepadButtonJustPressed[button]
+}
+
+func (s *EbitenInputSource) GamepadAxis(axis ebiten.StandardGamepadAxis) float64 {
+	return s.gamepadAxes[axis]
+}
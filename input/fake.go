@@ -0,0 +1,276 @@
+package input
+
+import (
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// FakeInputSource is a programmable InputSource. It is meant for tests and headless runs.
+//
+// Changes made with its methods (pressing keys, moving the cursor, typing characters etc.)
+// are applied on the next call to Update, the same way real input is polled once per frame.
+type FakeInputSource struct {
+	os OperatingSystem
+
+	cursorPosX     int
+	cursorPosY     int
+	nextCursorPosX int
+	nextCursorPosY int
+
+	mouseButtonPressed         [ebiten.MouseButtonMax + 1]bool
+	mouseButtonJustPressed     [ebiten.MouseButtonMax + 1]bool
+	nextMouseButtonPressed     [ebiten.MouseButtonMax + 1]bool
+	nextMouseButtonJustPressed [ebiten.MouseButtonMax + 1]bool
+
+	anyKeyPressed      bool
+	keyPressed         [ebiten.KeyMax + 1]bool
+	keyJustPressed     [ebiten.KeyMax + 1]bool
+	nextKeyPressed     [ebiten.KeyMax + 1]bool
+	nextKeyJustPressed [ebiten.KeyMax + 1]bool
+
+	inputChars     []rune
+	nextInputChars []rune
+
+	wheelX     float64
+	wheelY     float64
+	nextWheelX float64
+	nextWheelY float64
+
+	touches     []Touch
+	nextTouches []Touch
+
+	gamepadButtonPressed         [ebiten.StandardGamepadButtonMax + 1]bool
+	gamepadButtonJustPressed     [ebiten.StandardGamepadButtonMax + 1]bool
+	nextGamepadButtonPressed     [ebiten.StandardGamepadButtonMax + 1]bool
+	nextGamepadButtonJustPressed [ebiten.StandardGamepadButtonMax + 1]bool
+
+	gamepadAxes     [ebiten.StandardGamepadAxisMax + 1]float64
+	nextGamepadAxes [ebiten.StandardGamepadAxisMax + 1]float64
+}
+
+// NewFakeInputSource creates a new fake input source with nothing pressed.
+func NewFakeInputSource() *FakeInputSource {
+	return &FakeInputSource{
+		os: detectSystem(),
+	}
+}
+
+// Update applies the changes made since the previous update.
+func (f *FakeInputSource) Update() {
+	f.cursorPosX, f.cursorPosY = f.nextCursorPosX, f.nextCursorPosY
+
+	f.mouseButtonPressed = f.nextMouseButtonPressed
+	f.mouseButtonJustPressed = f.nextMouseButtonJustPressed
+	f.nextMouseButtonJustPressed = [ebiten.MouseButtonMax + 1]bool{}
+
+	f.keyPressed = f.nextKeyPressed
+	f.keyJustPressed = f.nextKeyJustPressed
+	f.nextKeyJustPressed = [ebiten.KeyMax + 1]bool{}
+
+	f.anyKeyPressed = false
+	for _, pressed := range f.keyPressed {
+		if pressed {
+			f.anyKeyPressed = true
+			break
+		}
+	}
+
+	f.inputChars = f.nextInputChars
+	f.nextInputChars = nil
+
+	f.wheelX, f.wheelY = f.nextWheelX, f.nextWheelY
+	f.nextWheelX, f.nextWheelY = 0, 0
+
+	f.touches = append(f.touches[:0], f.nextTouches...)
+	for i := range f.nextTouches {
+		f.nextTouches[i].JustPressed = false
+	}
+
+	f.gamepadButtonPressed = f.nextGamepadButtonPressed
+	f.gamepadButtonJustPressed = f.nextGamepadButtonJustPressed
+	f.nextGamepadButtonJustPressed = [ebiten.StandardGamepadButtonMax + 1]bool{}
+
+	f.gamepadAxes = f.nextGamepadAxes
+}
+
+// SetOS sets the operating system reported by the source.
+func (f *FakeInputSource) SetOS(os OperatingSystem) {
+	f.os = os
+}
+
+// MoveCursor moves the mouse cursor to the given position.
+func (f *FakeInputSource) MoveCursor(x, y int) {
+	f.nextCursorPosX, f.nextCursorPosY = x, y
+}
+
+// PressMouseButton presses the mouse button.
+func (f *FakeInputSource) PressMouseButton(button ebiten.MouseButton) {
+	if !f.nextMouseButtonPressed[button] {
+		f.nextMouseButtonJustPressed[button] = true
+	}
+
+	f.nextMouseButtonPressed[button] = true
+}
+
+// ReleaseMouseButton releases the mouse button.
+func (f *FakeInputSource) ReleaseMouseButton(button ebiten.MouseButton) {
+	f.nextMouseButtonPressed[button] = false
+	f.nextMouseButtonJustPressed[button] = false
+}
+
+// PressKey presses the key.
+func (f *FakeInputSource) PressKey(key ebiten.Key) {
+	if !f.nextKeyPressed[key] {
+		f.nextKeyJustPressed[key] = true
+	}
+
+	f.nextKeyPressed[key] = true
+}
+
+// ReleaseKey releases the key.
+func (f *FakeInputSource) ReleaseKey(key ebiten.Key) {
+	f.nextKeyPressed[key] = false
+	f.nextKeyJustPressed[key] = false
+}
+
+// PressGamepadButton presses the gamepad button.
+func (f *FakeInputSource) PressGamepadButton(button ebiten.StandardGamepadButton) {
+	if !f.nextGamepadButtonPressed[button] {
+		f.nextGamepadButtonJustPressed[button] = true
+	}
+
+	f.nextGamepadButtonPressed[button] = true
+}
+
+// ReleaseGamepadButton releases the gamepad button.
+func (f *FakeInputSource) ReleaseGamepadButton(button ebiten.StandardGamepadButton) {
+	f.nextGamepadButtonPressed[button] = false
+	f.nextGamepadButtonJustPressed[button] = false
+}
+
+// MoveGamepadAxis sets the gamepad axis value. It stays the same until changed.
+func (f *FakeInputSource) MoveGamepadAxis(axis ebiten.StandardGamepadAxis, value float64) {
+	f.nextGamepadAxes[axis] = value
+}
+
+// ReleaseAll releases all keys, mouse and gamepad buttons, centers the gamepad axes and removes all touches.
+func (f *FakeInputSource) ReleaseAll() {
+	f.nextMouseButtonPressed = [ebiten.MouseButtonMax + 1]bool{}
+	f.nextMouseButtonJustPressed = [ebiten.MouseButtonMax + 1]bool{}
+	f.nextKeyPressed = [ebiten.KeyMax + 1]bool{}
+	f.nextKeyJustPressed = [ebiten.KeyMax + 1]bool{}
+	f.nextTouches = nil
+	f.nextGamepadButtonPressed = [ebiten.StandardGamepadButtonMax + 1]bool{}
+	f.nextGamepadButtonJustPressed = [ebiten.StandardGamepadButtonMax + 1]bool{}
+	f.nextGamepadAxes = [ebiten.StandardGamepadAxisMax + 1]float64{}
+}
+
+// TypeChars types the characters. They are reported as input characters for one update.
+func (f *FakeInputSource) TypeChars(chars ...rune) {
+	f.nextInputChars = append(f.nextInputChars, chars...)
+}
+
+// ScrollWheel scrolls the mouse wheel. The offsets are reported for one update.
+func (f *FakeInputSource) ScrollWheel(xoff, yoff float64) {
+	f.nextWheelX += xoff
+	f.nextWheelY += yoff
+}
+
+// Touch starts a touch or moves an already started one.
+func (f *FakeInputSource) Touch(id ebiten.TouchID, x, y int) {
+	for i, touch := range f.nextTouches {
+		if touch.ID == id {
+			f.nextTouches[i].X = x
+			f.nextTouches[i].Y = y
+			return
+		}
+	}
+
+	f.nextTouches = append(f.nextTouches, Touch{ID: id, X: x, Y: y, JustPressed: true})
+}
+
+// ReleaseTouch ends the touch.
+func (f *FakeInputSource) ReleaseTouch(id ebiten.TouchID) {
+	for i, touch := range f.nextTouches {
+		if touch.ID == id {
+			f.nextTouches = append(f.nextTouches[:i:i], f.nextTouches[i+1:]...)
+			return
+		}
+	}
+}
+
+func (f *FakeInputSource) OS() OperatingSystem {
+	return f.os
+}
+
+func (f *FakeInputSource) CursorPosition() (int, int) {
+	return f.cursorPosX, f.cursorPosY
+}
+
+func (f *FakeInputSource) MouseButtonPressed(button ebiten.MouseButton) bool {
+	return f.mouseButtonPressed[button]
+}
+
+func (f *FakeInputSource) MouseButtonJustPressed(button ebiten.MouseButton) bool {
+	return f.mouseButtonJustPressed[button]
+}
+
+func (f *FakeInputSource) KeyPressed(key ebiten.Key) bool {
+	if left, right, ok := sidedKeys(key); ok {
+		return f.keyPressed[key] || f.keyPressed[left] || f.keyPressed[right]
+	}
+
+	return f.keyPressed[key]
+}
+
+func (f *FakeInputSource) KeyJustPressed(key ebiten.Key) bool {
+	if left, right, ok := sidedKeys(key); ok {
+		return f.keyJustPressed[key] || f.keyJustPressed[left] || f.keyJustPressed[right]
+	}
+
+	return f.keyJustPressed[key]
+}
+
+// sidedKeys returns the left and right variants of a modifier key.
+// Ebiten reports e.g. KeyControl as pressed when either KeyControlLeft or KeyControlRight is pressed.
+func sidedKeys(key ebiten.Key) (ebiten.Key, ebiten.Key, bool) {
+	switch key {
+	case ebiten.KeyAlt:
+		return ebiten.KeyAltLeft, ebiten.KeyAltRight, true
+	case ebiten.KeyControl:
+		return ebiten.KeyControlLeft, ebiten.KeyControlRight, true
+	case ebiten.KeyShift:
+		return ebiten.KeyShiftLeft, ebiten.KeyShiftRight, true
+	case ebiten.KeyMeta:
+		return ebiten.KeyMetaLeft, ebiten.KeyMetaRight, true
+	}
+
+	return KeyNone, KeyNone, false
+}
+
+func (f *FakeInputSource) AnyKeyPressed() bool {
+	return f.anyKeyPressed
+}
+
+func (f *FakeInputSource) InputChars() []rune {
+	return f.inputChars
+}
+
+func (f *FakeInputSource) Wheel() (float64, float64) {
+	return f.wheelX, f.wheelY
+}
+
+func (f *FakeInputSource) Touches() []Touch {
+	return f.touches
+}
+
+func (f *FakeInputSource) GamepadButtonPressed(button ebiten.StandardGamepadButton) bool {
+	return f.gamepadButtonPressed[button]
+}
+
+func (f *FakeInputSource) GamepadButtonJustPressed(button ebiten.StandardGamepadButton) bool {
+	return f.gamepadButtonJustPressed[button]
+}
+
+func (f *FakeInputSource) GamepadAxis(axis ebiten.StandardGamepadAxis) float64 {
+	return f.gamepadAxes[axis]
+}
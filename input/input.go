@@ -2,7 +2,6 @@ package input
 
 import (
 	ebiten "github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 type OperatingSystem string
@@ -13,69 +12,63 @@ const (
 	MacOS   OperatingSystem = "macos"
 )
 
-var (
-	OS OperatingSystem
-
-	CursorPosX int
-	CursorPosY int
-
-	MouseLeftButtonPressed           bool
-	MouseLeftButtonJustPressed       bool
-	MouseLastUpdateLeftButtonPressed bool
-
-	MouseRightButtonPressed           bool
-	MouseRightButtonJustPressed       bool
-	MouseLastUpdateRightButtonPressed bool
-
-	InputChars []rune
-
-	AnyKeyPressed     bool
-	AnyJustKeyPressed bool
-	KeyPressed        [ebiten.KeyMax + 1]bool
-	KeyJustPressed    [ebiten.KeyMax + 1]bool
-)
-
-func init() {
-	DetectSystem()
-}
-
-func OSWindows() bool {
-	return OS == Windows
-}
-
-func OSLinux() bool {
-	return OS == Linux
-}
-
-func OSMacOS() bool {
-	return OS == MacOS
-}
-
 const (
 	KeyNone ebiten.Key = -1
 )
 
-func Update() {
-	CursorPosX, CursorPosY = ebiten.CursorPosition()
-
-	MouseLeftButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
-	MouseLeftButtonJustPressed = inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
-	MouseLastUpdateLeftButtonPressed = MouseLeftButtonPressed
-
-	MouseRightButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
-	MouseRightButtonJustPressed = inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
-	MouseLastUpdateRightButtonPressed = MouseRightButtonPressed
-
-	InputChars = ebiten.AppendInputChars(InputChars)
-	AnyKeyPressed = false
+// InputSource is a source of the input state read by the GUI and its components.
+// The state is polled once per GUI update and stays the same until the next update.
+type InputSource interface {
+	// Update polls the current input state.
+	Update()
+	// OS returns the operating system the input comes from.
+	OS() OperatingSystem
+	// CursorPosition returns the mouse cursor position.
+	CursorPosition() (x int, y int)
+	// MouseButtonPressed returns whether the mouse button is pressed.
+	MouseButtonPressed(button ebiten.MouseButton) bool
+	// MouseButtonJustPressed returns whether the mouse button was pressed in the current update.
+	MouseButtonJustPressed(button ebiten.MouseButton) bool
+	// KeyPressed returns whether the key is pressed.
+	KeyPressed(key ebiten.Key) bool
+	// KeyJustPressed returns whether the key was pressed in the current update.
+	KeyJustPressed(key ebiten.Key) bool
+	// AnyKeyPressed returns whether any key is pressed.
+	AnyKeyPressed() bool
+	// InputChars returns the characters typed in the current update.
+	InputChars() []rune
+	// Wheel returns the mouse wheel offsets of the current update.
+	Wheel() (xoff float64, yoff float64)
+	// Touches returns the active touches.
+	Touches() []Touch
+	// GamepadButtonPressed returns whether the button is pressed on any gamepad with the standard layout.
+	GamepadButtonPressed(button ebiten.StandardGamepadButton) bool
+	// GamepadButtonJustPressed returns whether the button was pressed in the current update on any gamepad with the standard layout.
+	GamepadButtonJustPressed(button ebiten.StandardGamepadButton) bool
+	// GamepadAxis returns the axis value of the gamepads with the standard layout.
+	// If more than one gamepad is connected, the value with the largest magnitude is returned.
+	GamepadAxis(axis ebiten.StandardGamepadAxis) float64
+}
 
-	DetectPressedKeys()
+// Touch represents a single touch on a touch screen.
+type Touch struct {
+	ID          ebiten.TouchID
+	X           int
+	Y           int
+	JustPressed bool
 }
 
-func Draw() {
+// IsMacOS returns whether the operating system is MacOS.
+func (os OperatingSystem) IsMacOS() bool {
+	return os == MacOS
+}
 
+// IsWindows returns whether the operating system is Windows.
+func (os OperatingSystem) IsWindows() bool {
+	return os == Windows
 }
 
-func AfterDraw() {
-	InputChars = InputChars[:0]
+// IsLinux returns whether the operating system is Linux.
+func (os OperatingSystem) IsLinux() bool {
+	return os == Linux
 }
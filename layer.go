@@ -0,0 +1,189 @@
+package chopstiqs
+
+import (
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/input"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// Layer is a level of the gui's layer stack. Higher layers are drawn on top of the lower ones
+// and receive the mouse input first, so the lower layers don't see the cursor while it's over a component of a higher layer.
+type Layer int
+
+const (
+	// LayerBase holds the root container.
+	LayerBase Layer = iota
+	// LayerModal holds dialogs. An open dialog blocks all input to the layers below and to the dialogs opened before it.
+	LayerModal
+	// LayerPopup holds popups, like dropdown lists and context menus. It's above the modal layer,
+	// so the components of a dialog can open popups too.
+	LayerPopup
+	// LayerTooltip holds tooltips.
+	LayerTooltip
+
+	layerCount
+)
+
+// newLayerContainer creates the transparent container covering the screen that holds a layer's components.
+func (gui *GUI) newLayerContainer() *component.Container {
+	c := component.NewContainer(nil)
+	c.SetEventManager(gui.eventManager)
+	c.SetAnimator(gui.animator)
+	c.AddFocusedHandler(gui.handleFocusEvent)
+	c.AddTooltipHandler(gui.handleTooltipEvent)
+	c.AddPopupHandler(gui.handlePopupEvent)
+
+	return c
+}
+
+// AddToLayer adds the component to the layer. The components of the layers above the base layer
+// are positioned relative to the screen. Components added to the base layer are added to the root container.
+func (gui *GUI) AddToLayer(layer Layer, c component.Component) {
+	if layer == LayerBase {
+		gui.rootContainer.AddComponent(c)
+		return
+	}
+
+	gui.layers[layer].AddComponent(c)
+}
+
+// LayerContainer returns the container holding the layer's components. The base layer's container is the root container.
+func (gui *GUI) LayerContainer(layer Layer) *component.Container {
+	if layer == LayerBase {
+		return gui.rootContainer
+	}
+
+	return gui.layers[layer]
+}
+
+// RemoveFromLayer removes the component from the layer.
+func (gui *GUI) RemoveFromLayer(layer Layer, c component.Component) {
+	if gui.focusedComponent != nil && contains(c, gui.focusedComponent) {
+		gui.focusedComponent.SetFocused(false)
+		gui.focusedComponent = nil
+	}
+
+	if layer == LayerBase {
+		gui.rootContainer.RemoveComponent(c)
+		return
+	}
+
+	gui.layers[layer].RemoveComponent(c)
+}
+
+// OpenDialog shows the dialog on the modal layer and focuses its first focusable component.
+// When the dialog is closed, it's removed from the layer and the previously focused component is focused again.
+func (gui *GUI) OpenDialog(d *component.Dialog) {
+	if !d.Hidden() {
+		return
+	}
+
+	previouslyFocused := gui.focusedComponent
+
+	d.SetHidden(false)
+	if !contains(gui.layers[LayerModal], d) {
+		gui.AddToLayer(LayerModal, d)
+	}
+
+	if components := focusOrder(d); len(components) > 0 {
+		gui.Focus(components[0])
+	} else if gui.focusedComponent != nil {
+		gui.focusedComponent.SetFocused(false)
+		gui.focusedComponent = nil
+	}
+
+	d.ClosedEvent.AddOneTimeHandler(func(args *component.DialogClosedEventArgs) {
+		// the closed event is deferred, so the dialog may have been opened again since
+		if !d.Hidden() {
+			return
+		}
+
+		gui.RemoveFromLayer(LayerModal, d)
+
+		if previouslyFocused != nil && gui.topDialog() == nil {
+			gui.Focus(previouslyFocused)
+		}
+	})
+}
+
+// topDialog returns the open dialog added last, or nil if no dialog is open.
+func (gui *GUI) topDialog() *component.Dialog {
+	components := gui.layers[LayerModal].Components()
+	for i := len(components) - 1; i >= 0; i-- {
+		if d, ok := components[i].(*component.Dialog); ok && !d.Hidden() {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// focusableComponents returns the components the focus is moved between. While a dialog is open, the focus is kept in it.
+func (gui *GUI) focusableComponents() []component.Component {
+	if d := gui.topDialog(); d != nil {
+		return focusOrder(d)
+	}
+
+	return focusOrder(gui.rootContainer, gui.layers[LayerPopup])
+}
+
+// fireEvents fires the events of the layers from the top one to the base one.
+// Tooltips don't take the mouse input. The cursor is hidden from the layers below a component under the cursor, and an open dialog blocks all input to the layers below.
+func (gui *GUI) fireEvents() {
+	var in input.InputSource = gui.input
+
+	for layer := layerCount - 1; layer > LayerBase; layer-- {
+		c := gui.layers[layer]
+		c.FireEvents(in)
+
+		switch {
+		case layer == LayerModal && gui.topDialog() != nil:
+			in = input.BlockedInputSource{InputSource: in}
+		case layer != LayerTooltip && cursorOver(c):
+			in = input.CoveredInputSource{InputSource: in}
+		}
+	}
+
+	gui.rootContainer.FireEvents(in)
+}
+
+// drawLayers draws the layers above the base layer, resizing them to cover the screen and positioning the popups.
+func (gui *GUI) drawLayers(guiImage *ebiten.Image) {
+	bounds := guiImage.Bounds()
+
+	for layer := LayerBase + 1; layer < layerCount; layer++ {
+		c := gui.layers[layer]
+		if c.Width() != bounds.Dx() || c.Height() != bounds.Dy() {
+			c.SetDimensions(bounds.Dx(), bounds.Dy())
+		}
+
+		if layer == LayerPopup {
+			gui.positionPopups()
+		}
+
+		if len(c.Components()) == 0 {
+			continue
+		}
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(bounds.Min.X), float64(bounds.Min.Y))
+		guiImage.DrawImage(c.Draw(), op)
+	}
+}
+
+// contains returns whether the component is the container or one of its descendants.
+func contains(container, c component.Component) bool {
+	if container == c {
+		return true
+	}
+
+	if cc, ok := container.(componentsContainer); ok {
+		for _, child := range cc.Components() {
+			if contains(child, c) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,207 @@
+package chopstiqs
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func clickAt(t *testing.T, gui *GUI, in *input.FakeInputSource, x, y int) {
+	t.Helper()
+
+	in.MoveCursor(x, y)
+	step(t, gui)
+
+	in.PressMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+
+	in.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	step(t, gui)
+}
+
+func newTestDialog(components ...component.Component) *component.Dialog {
+	d := component.NewDialog(&component.DialogOptions{
+		Layout: &component.VerticalListLayout{RowGap: 5},
+		Width:  option.Int(50),
+		Height: option.Int(30),
+	})
+	d.AddComponents(components...)
+
+	return d
+}
+
+func TestGUI_AddToLayer(t *testing.T) {
+	is := is.New(t)
+
+	base := component.NewButton(nil)
+	gui, in := newTestGUI(t, base)
+
+	popup := component.NewButton(nil)
+	gui.AddToLayer(LayerPopup, popup)
+	step(t, gui)
+
+	baseClicked, popupClicked := 0, 0
+	base.AddClickedHandler(func(args *component.ButtonClickedEventArgs) { baseClicked++ })
+	popup.AddClickedHandler(func(args *component.ButtonClickedEventArgs) { popupClicked++ })
+
+	clickAt(t, gui, in, 1, 1)
+	is.Equal(popupClicked, 1)
+	is.Equal(baseClicked, 0) // the popup covers the base button
+
+	gui.RemoveFromLayer(LayerPopup, popup)
+	clickAt(t, gui, in, 1, 1)
+	is.Equal(popupClicked, 1)
+	is.Equal(baseClicked, 1)
+}
+
+func TestGUI_OpenDialog(t *testing.T) {
+	is := is.New(t)
+
+	base := component.NewButton(nil)
+	gui, in := newTestGUI(t, base)
+	gui.Focus(base)
+
+	yes := component.NewButton(nil)
+	no := component.NewButton(nil)
+	d := newTestDialog(yes, no)
+
+	gui.OpenDialog(d)
+	step(t, gui)
+	is.Equal(gui.FocusedComponent(), yes) // the dialog's first component is focused
+
+	// the panel is centered in the 200x200 gui image
+	x, y := d.Panel().Position()
+	is.Equal(x, float64(200-d.Panel().WidthWithPadding())/2)
+	is.Equal(y, float64(200-d.Panel().HeightWithPadding())/2)
+
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), no)
+	pressKey(t, gui, in, ebiten.KeyTab)
+	is.Equal(gui.FocusedComponent(), yes) // the focus is kept in the dialog
+
+	baseClicked := 0
+	base.AddClickedHandler(func(args *component.ButtonClickedEventArgs) { baseClicked++ })
+
+	clickAt(t, gui, in, 1, 1)
+	is.Equal(baseClicked, 0) // the dialog blocks the input to the base layer
+	is.True(gui.CursorOverGUI())
+	is.True(gui.MouseConsumed())
+	is.True(gui.KeyboardConsumed())
+
+	closed, canceled := 0, false
+	d.AddClosedHandler(func(args *component.DialogClosedEventArgs) {
+		closed++
+		canceled = args.Canceled
+	})
+
+	pressKey(t, gui, in, ebiten.KeyEscape)
+	is.True(d.Hidden())
+	is.Equal(closed, 1)
+	is.True(canceled)
+	is.Equal(gui.FocusedComponent(), base) // the focus is restored
+	is.Equal(len(gui.layers[LayerModal].Components()), 0)
+
+	clickAt(t, gui, in, 1, 1)
+	is.Equal(baseClicked, 1)
+}
+
+func TestGUI_OpenDialog_close(t *testing.T) {
+	is := is.New(t)
+
+	gui, in := newTestGUI(t)
+
+	yes := component.NewButton(nil)
+	d := newTestDialog(yes)
+	yes.AddClickedHandler(func(args *component.ButtonClickedEventArgs) {
+		d.Close()
+	})
+
+	var closedArgs *component.DialogClosedEventArgs
+	d.AddClosedHandler(func(args *component.DialogClosedEventArgs) {
+		closedArgs = args
+	})
+
+	gui.OpenDialog(d)
+	step(t, gui)
+
+	x, y := yes.AbsPosition()
+	clickAt(t, gui, in, int(x)+1, int(y)+1)
+	is.True(closedArgs != nil)
+	is.Equal(closedArgs.Dialog, d)
+	is.True(!closedArgs.Canceled)
+
+	step(t, gui)
+	is.True(!gui.KeyboardConsumed())
+
+	gui.OpenDialog(d) // dialogs can be opened again
+	step(t, gui)
+	is.True(!d.Hidden())
+	is.Equal(len(gui.layers[LayerModal].Components()), 1)
+}
+
+func TestGUI_OpenDialog_textInput(t *testing.T) {
+	is := is.New(t)
+
+	gui, in := newTestGUI(t)
+
+	ti := component.NewTextInput(nil)
+	d := newTestDialog(ti)
+	gui.OpenDialog(d)
+	step(t, gui)
+	is.True(ti.Focused())
+
+	// Escape unfocuses the text input, not the dialog
+	pressKey(t, gui, in, ebiten.KeyEscape)
+	is.True(!ti.Focused())
+	is.True(!d.Hidden())
+
+	pressKey(t, gui, in, ebiten.KeyEscape)
+	is.True(d.Hidden())
+}
+
+func TestGUI_OpenDialog_stacked(t *testing.T) {
+	is := is.New(t)
+
+	gui, in := newTestGUI(t)
+
+	// the panels don't overlap, so the lower dialog's button isn't covered by the upper dialog's panel
+	newDialog := func(alignment option.HorizontalAlignment, b *component.Button) *component.Dialog {
+		d := component.NewDialog(&component.DialogOptions{
+			Layout:              &component.VerticalListLayout{},
+			HorizontalAlignment: &alignment,
+		})
+		d.AddComponent(b)
+
+		return d
+	}
+
+	lower := component.NewButton(nil)
+	d1 := newDialog(option.AlignmentLeft, lower)
+	d2 := newDialog(option.AlignmentRight, component.NewButton(nil))
+
+	gui.OpenDialog(d1)
+	gui.OpenDialog(d2)
+	step(t, gui)
+
+	lowerClicked := 0
+	lower.AddClickedHandler(func(args *component.ButtonClickedEventArgs) { lowerClicked++ })
+
+	// only the dialog on top receives the input
+	x, y := lower.AbsPosition()
+	clickAt(t, gui, in, int(x)+1, int(y)+1)
+	is.Equal(lowerClicked, 0)
+
+	pressKey(t, gui, in, ebiten.KeyEscape)
+	is.True(d2.Hidden())
+	is.True(!d1.Hidden())
+
+	clickAt(t, gui, in, int(x)+1, int(y)+1)
+	is.Equal(lowerClicked, 1)
+
+	pressKey(t, gui, in, ebiten.KeyEscape)
+	is.True(d1.Hidden())
+}
@@ -0,0 +1,76 @@
+package chopstiqs
+
+import (
+	"image"
+
+	"github.com/fglo/chopstiqs/component"
+)
+
+// openPopup is a popup shown on the popup layer for the component that opened it.
+type openPopup struct {
+	owner component.Component
+	popup component.Component
+	// position is where the popup was asked to be placed, or nil if it's placed below its owner
+	position *image.Point
+}
+
+// handlePopupEvent shows the popups opened by the components, like dropdown lists, on the popup layer.
+func (gui *GUI) handlePopupEvent(args *component.ComponentPopupEventArgs) {
+	if args.Shown {
+		gui.showPopup(args.Component, args.Popup, args.Position)
+	} else {
+		gui.hidePopup(args.Popup)
+	}
+}
+
+func (gui *GUI) showPopup(owner, popup component.Component, position *image.Point) {
+	for i, p := range gui.popups {
+		if p.popup == popup {
+			gui.popups[i].position = position
+			return
+		}
+	}
+
+	gui.popups = append(gui.popups, openPopup{owner: owner, popup: popup, position: position})
+	gui.AddToLayer(LayerPopup, popup)
+}
+
+func (gui *GUI) hidePopup(popup component.Component) {
+	for i, p := range gui.popups {
+		if p.popup == popup {
+			gui.popups = append(gui.popups[:i:i], gui.popups[i+1:]...)
+			gui.RemoveFromLayer(LayerPopup, popup)
+			return
+		}
+	}
+}
+
+// positionPopups places the popups below the components that opened them, or at the positions they were opened at, keeping them within the screen.
+// If there isn't enough space below a component or the position, the popup is placed above it.
+// The popups are positioned on every draw, so they follow the components and their own size changes.
+func (gui *GUI) positionPopups() {
+	screenWidth, screenHeight := gui.layers[LayerPopup].Dimensions()
+
+	for _, p := range gui.popups {
+		width, height := p.popup.Dimensions()
+
+		ownerX, ownerY := p.owner.AbsPosition()
+		x, y, top := int(ownerX), int(ownerY)+p.owner.HeightWithPadding(), int(ownerY)
+		if p.position != nil {
+			x, y, top = p.position.X, p.position.Y, p.position.Y
+		}
+
+		if x+width > screenWidth {
+			x = screenWidth - width
+		}
+
+		if y+height > screenHeight && top-height >= 0 {
+			y = top - height
+		}
+
+		x, y = max(x, 0), max(y, 0)
+		if float64(x) != p.popup.PosX() || float64(y) != p.popup.PosY() {
+			p.popup.SetPosition(float64(x), float64(y))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package chopstiqs
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func rightClickAt(t *testing.T, gui *GUI, in *input.FakeInputSource, x, y int) {
+	t.Helper()
+
+	in.MoveCursor(x, y)
+	step(t, gui)
+
+	in.PressMouseButton(ebiten.MouseButtonRight)
+	step(t, gui)
+
+	in.ReleaseMouseButton(ebiten.MouseButtonRight)
+	step(t, gui)
+}
+
+func TestGUI_TextInputContextMenu(t *testing.T) {
+	is := is.New(t)
+
+	ti := component.NewTextInput(&component.TextInputOptions{Width: option.Int(100)})
+	ti.SetValue("hello world")
+	gui, in := newTestGUI(t, ti)
+	step(t, gui)
+
+	rightClickAt(t, gui, in, 20, 5)
+	is.True(ti.IsContextMenuOpen())
+
+	popups := gui.layers[LayerPopup].Components()
+	is.Equal(len(popups), 1)
+
+	x, y := popups[0].Position()
+	is.Equal(x, 20.0)
+	is.Equal(y, 5.0) // the menu is placed at the mouse cursor
+
+	// the last item selects all
+	_, height := popups[0].Dimensions()
+	clickAt(t, gui, in, 25, 5+height-5)
+	is.True(!ti.IsContextMenuOpen())
+	is.Equal(len(gui.layers[LayerPopup].Components()), 0)
+	is.True(ti.HasSelectedText())
+	is.Equal(gui.FocusedComponent(), ti) // the focus comes back from the menu
+
+	// there isn't enough space for the menu below the position
+	ti.OpenContextMenu(20, 190)
+	step(t, gui)
+	x, y = gui.layers[LayerPopup].Components()[0].Position()
+	is.Equal(x, 20.0)
+	is.Equal(y, float64(190-height))
+}
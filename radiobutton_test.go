@@ -0,0 +1,29 @@
+package chopstiqs
+
+import (
+	"testing"
+
+	"github.com/fglo/chopstiqs/component"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestGUI_RadioButton_keyboard(t *testing.T) {
+	is := is.New(t)
+
+	first := component.NewRadioButton(nil)
+	second := component.NewRadioButton(nil)
+	g := component.NewRadioGroup(&component.RadioGroupOptions{Buttons: []*component.RadioButton{first, second}})
+
+	gui, in := newTestGUI(t, first, second)
+	gui.Focus(first)
+	step(t, gui)
+
+	pressKey(t, gui, in, ebiten.KeySpace)
+	is.Equal(g.Selected(), first)
+
+	pressKey(t, gui, in, ebiten.KeyDown)
+	is.Equal(g.Selected(), second)
+	is.Equal(gui.FocusedComponent(), second)
+	is.True(!first.Focused())
+}
@@ -0,0 +1,46 @@
+package snapshot
+
+import (
+	"os"
+	"testing"
+
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// game runs the tests in its first update, while ebiten's game loop is running.
+type game struct {
+	m    *testing.M
+	code int
+}
+
+// Main runs the tests inside ebiten's game loop and exits with their exit code.
+// It should be called from the tested package's TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		snapshot.Main(m)
+//	}
+func Main(m *testing.M) {
+	g := &game{
+		m:    m,
+		code: 1,
+	}
+
+	ebiten.SetWindowSize(64, 64)
+
+	if err := ebiten.RunGame(g); err != nil {
+		panic(err)
+	}
+
+	os.Exit(g.code)
+}
+
+func (g *game) Update() error {
+	g.code = g.m.Run()
+	return ebiten.Termination
+}
+
+func (g *game) Draw(screen *ebiten.Image) {}
+
+func (g *game) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
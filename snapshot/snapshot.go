@@ -0,0 +1,180 @@
+// Package snapshot compares rendered images with golden PNG images.
+//
+// Golden images are stored in the testdata/snapshots directory of the tested package.
+// Run the tests with the -update flag to create or update them:
+//
+//	go test ./component -update
+//
+// When an image doesn't match its golden image, the actual image and an image highlighting
+// the differing pixels are written next to the golden image with the .actual.png and .diff.png suffixes.
+//
+// Images can only be read back from the GPU while the game is running,
+// so the tests using this package have to be run with Main.
+package snapshot
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// Dir is the directory the golden images are stored in, relative to the tested package.
+const Dir = "testdata/snapshots"
+
+var update = flag.Bool("update", false, "update the golden images")
+
+var diffColor = color.RGBA{255, 0, 0, 255}
+
+// Assert compares the image with the golden image with the given name.
+// If the -update flag is set, the golden image is overwritten instead.
+func Assert(t testing.TB, img *ebiten.Image, name string) {
+	t.Helper()
+
+	assertImage(t, toRGBA(img), name)
+}
+
+func assertImage(t testing.TB, actual *image.RGBA, name string) {
+	t.Helper()
+
+	goldenPath := filepath.Join(Dir, name+".png")
+	actualPath := filepath.Join(Dir, name+".actual.png")
+	diffPath := filepath.Join(Dir, name+".diff.png")
+
+	if *update {
+		if err := writePNG(goldenPath, actual); err != nil {
+			t.Fatalf("snapshot %s: %v", name, err)
+		}
+
+		os.Remove(actualPath)
+		os.Remove(diffPath)
+
+		return
+	}
+
+	golden, err := readPNG(goldenPath)
+	if err != nil {
+		t.Fatalf("snapshot %s: %v (run the tests with -update to create it)", name, err)
+	}
+
+	diff, n := compare(golden, actual)
+	if n == 0 {
+		os.Remove(actualPath)
+		os.Remove(diffPath)
+		return
+	}
+
+	if err := writePNG(actualPath, actual); err != nil {
+		t.Errorf("snapshot %s: %v", name, err)
+	}
+
+	if err := writePNG(diffPath, diff); err != nil {
+		t.Errorf("snapshot %s: %v", name, err)
+	}
+
+	if golden.Bounds().Size() != actual.Bounds().Size() {
+		t.Errorf("snapshot %s: size %v doesn't match the golden image size %v, see %s", name, actual.Bounds().Size(), golden.Bounds().Size(), diffPath)
+		return
+	}
+
+	t.Errorf("snapshot %s: %d pixels don't match the golden image, see %s", name, n, diffPath)
+}
+
+// compare compares the images pixel by pixel.
+// It returns an image with the differing pixels highlighted and the number of differing pixels.
+// Images of different sizes are compared on their common area and all the remaining pixels are counted as differing.
+func compare(golden, actual *image.RGBA) (*image.RGBA, int) {
+	gb := golden.Bounds()
+	ab := actual.Bounds()
+
+	w := max(gb.Dx(), ab.Dx())
+	h := max(gb.Dy(), ab.Dy())
+
+	diff := image.NewRGBA(image.Rect(0, 0, w, h))
+	n := 0
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x >= gb.Dx() || y >= gb.Dy() || x >= ab.Dx() || y >= ab.Dy() {
+				diff.SetRGBA(x, y, diffColor)
+				n++
+				continue
+			}
+
+			g := golden.RGBAAt(gb.Min.X+x, gb.Min.Y+y)
+			a := actual.RGBAAt(ab.Min.X+x, ab.Min.Y+y)
+
+			if g != a {
+				diff.SetRGBA(x, y, diffColor)
+				n++
+				continue
+			}
+
+			diff.SetRGBA(x, y, faded(a))
+		}
+	}
+
+	return diff, n
+}
+
+// faded returns the color in gray, with lowered contrast, so the highlighted pixels stand out in the diff image.
+func faded(c color.RGBA) color.RGBA {
+	gray := uint8((uint16(c.R) + uint16(c.G) + uint16(c.B)) / 3 / 4)
+	return color.RGBA{gray, gray, gray, 255}
+}
+
+// toRGBA reads the image pixels back from the GPU.
+func toRGBA(img *ebiten.Image) *image.RGBA {
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	img.ReadPixels(rgba.Pix)
+
+	return rgba
+}
+
+func readPNG(path string) (*image.RGBA, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+
+	if rgba, ok := img.(*image.RGBA); ok {
+		return rgba, nil
+	}
+
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			rgba.Set(x, y, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+
+	return rgba, nil
+}
+
+func writePNG(path string, img *image.RGBA) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return fmt.Errorf("encoding %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
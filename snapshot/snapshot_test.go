@@ -0,0 +1,87 @@
+package snapshot
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func newTestImage(w, h int, clr color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetRGBA(x, y, clr)
+		}
+	}
+
+	return img
+}
+
+func Test_compare(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	black := color.RGBA{0, 0, 0, 255}
+
+	oneDifferentPixel := newTestImage(4, 4, white)
+	oneDifferentPixel.SetRGBA(1, 2, black)
+
+	tests := []struct {
+		name       string
+		golden     *image.RGBA
+		actual     *image.RGBA
+		wantN      int
+		wantBounds image.Rectangle
+	}{
+		{
+			name:       "equal images",
+			golden:     newTestImage(4, 4, white),
+			actual:     newTestImage(4, 4, white),
+			wantN:      0,
+			wantBounds: image.Rect(0, 0, 4, 4),
+		},
+		{
+			name:       "one different pixel",
+			golden:     newTestImage(4, 4, white),
+			actual:     oneDifferentPixel,
+			wantN:      1,
+			wantBounds: image.Rect(0, 0, 4, 4),
+		},
+		{
+			name:       "bigger actual image",
+			golden:     newTestImage(4, 4, white),
+			actual:     newTestImage(5, 4, white),
+			wantN:      4,
+			wantBounds: image.Rect(0, 0, 5, 4),
+		},
+		{
+			name:       "smaller actual image",
+			golden:     newTestImage(4, 4, white),
+			actual:     newTestImage(4, 2, white),
+			wantN:      8,
+			wantBounds: image.Rect(0, 0, 4, 4),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+
+			diff, n := compare(tt.golden, tt.actual)
+			is.Equal(n, tt.wantN)
+			is.Equal(diff.Bounds(), tt.wantBounds)
+		})
+	}
+}
+
+func Test_compare_highlightsDifferentPixels(t *testing.T) {
+	is := is.New(t)
+
+	golden := newTestImage(4, 4, color.RGBA{255, 255, 255, 255})
+	actual := newTestImage(4, 4, color.RGBA{255, 255, 255, 255})
+	actual.SetRGBA(1, 2, color.RGBA{0, 0, 0, 255})
+
+	diff, _ := compare(golden, actual)
+	is.Equal(diff.RGBAAt(1, 2), diffColor)
+	is.True(diff.RGBAAt(0, 0) != diffColor)
+}
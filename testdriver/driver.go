@@ -0,0 +1,268 @@
+// Package testdriver runs a chopstiqs GUI without a window.
+// It feeds scripted mouse and keyboard input to the GUI and steps its Update and Draw frame by frame,
+// so tests can exercise the same event pipeline the components go through in a running game.
+package testdriver
+
+import (
+	"time"
+
+	"github.com/fglo/chopstiqs"
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/input"
+	"github.com/fglo/chopstiqs/timer"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+)
+
+// Driver wraps a GUI and drives it with synthetic input.
+//
+// Every input method changes the input state and steps one frame,
+// so consecutive calls are seen by the GUI the same way as consecutive frames of real input.
+type Driver struct {
+	gui    *chopstiqs.GUI
+	input  *input.FakeInputSource
+	clock  *timer.FakeClock
+	screen *ebiten.Image
+
+	frame int
+}
+
+// FrameDuration is the time the driver's clock advances by every frame, matching ebiten's default 60 ticks per second.
+const FrameDuration = time.Second / 60
+
+// New creates a new driver with a screen of the given size.
+// The GUI is created with the given options, but its input and clock are always replaced with fake ones.
+func New(screenWidth, screenHeight int, opt *chopstiqs.GUIOptions) *Driver {
+	d := &Driver{
+		input:  input.NewFakeInputSource(),
+		clock:  timer.NewFakeClock(),
+		screen: ebiten.NewImage(screenWidth, screenHeight),
+	}
+
+	guiOptions := chopstiqs.GUIOptions{}
+	if opt != nil {
+		guiOptions = *opt
+	}
+	guiOptions.Input = d.input
+	guiOptions.Clock = d.clock
+
+	d.gui = chopstiqs.NewGUI(&guiOptions)
+
+	return d
+}
+
+// GUI returns the driven GUI.
+func (d *Driver) GUI() *chopstiqs.GUI {
+	return d.gui
+}
+
+// Input returns the fake input source read by the GUI.
+// It can be used to set up input states the driver methods don't cover.
+func (d *Driver) Input() *input.FakeInputSource {
+	return d.input
+}
+
+// Clock returns the fake clock read by the GUI's scheduler.
+func (d *Driver) Clock() *timer.FakeClock {
+	return d.clock
+}
+
+// Screen returns the image the GUI is drawn to.
+func (d *Driver) Screen() *ebiten.Image {
+	return d.screen
+}
+
+// Frame returns the number of frames stepped so far.
+func (d *Driver) Frame() int {
+	return d.frame
+}
+
+// Step runs a single frame: it advances the clock by FrameDuration, updates the GUI and draws it to the screen.
+// The GUI must have a root container set.
+func (d *Driver) Step() {
+	d.clock.Advance(FrameDuration)
+	d.gui.Update()
+
+	d.screen.Clear()
+	d.gui.Draw(d.screen)
+
+	d.frame++
+}
+
+// StepFrames runs n frames.
+func (d *Driver) StepFrames(n int) {
+	for i := 0; i < n; i++ {
+		d.Step()
+	}
+}
+
+// StepDuration runs as many frames as it takes the clock to advance by at least d.
+func (d *Driver) StepDuration(dur time.Duration) {
+	d.StepFrames(int((dur + FrameDuration - 1) / FrameDuration))
+}
+
+// MoveCursor moves the mouse cursor to the given position and steps a frame.
+func (d *Driver) MoveCursor(x, y int) {
+	d.input.MoveCursor(x, y)
+	d.Step()
+}
+
+// MouseDown presses the mouse button and steps a frame.
+func (d *Driver) MouseDown(button ebiten.MouseButton) {
+	d.input.PressMouseButton(button)
+	d.Step()
+}
+
+// MouseUp releases the mouse button and steps a frame.
+func (d *Driver) MouseUp(button ebiten.MouseButton) {
+	d.input.ReleaseMouseButton(button)
+	d.Step()
+}
+
+// Click moves the cursor to the given position and clicks the left mouse button.
+func (d *Driver) Click(x, y int) {
+	d.MoveCursor(x, y)
+	d.MouseDown(ebiten.MouseButtonLeft)
+	d.MouseUp(ebiten.MouseButtonLeft)
+}
+
+// ClickComponent clicks the center of the component.
+// Component positions are known after the first frame, so the GUI should be stepped at least once before.
+func (d *Driver) ClickComponent(c component.Component) {
+	d.Click(center(c))
+}
+
+// Find returns the visible component labelled with the text, like a button or a checkbox, or the label itself if it isn't a part of a component.
+// The popups and the dialogs are searched before the root container, so the components shown on top are found first.
+// It returns nil if there's no such component.
+func (d *Driver) Find(text string) component.Component {
+	for _, layer := range []chopstiqs.Layer{chopstiqs.LayerPopup, chopstiqs.LayerModal, chopstiqs.LayerBase} {
+		container := d.gui.LayerContainer(layer)
+		if container == nil {
+			continue
+		}
+
+		if c := find(container, text); c != nil {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// ClickLabel clicks the center of the component labelled with the text. It returns false if there's no such component.
+func (d *Driver) ClickLabel(text string) bool {
+	c := d.Find(text)
+	if c == nil {
+		return false
+	}
+
+	d.ClickComponent(c)
+
+	return true
+}
+
+// Drag presses the left mouse button at the start position, moves the cursor to the end position
+// and releases the button there.
+func (d *Driver) Drag(fromX, fromY, toX, toY int) {
+	d.MoveCursor(fromX, fromY)
+	d.MouseDown(ebiten.MouseButtonLeft)
+	d.MoveCursor(toX, toY)
+	d.MouseUp(ebiten.MouseButtonLeft)
+}
+
+// ScrollWheel scrolls the mouse wheel at the current cursor position and steps a frame.
+func (d *Driver) ScrollWheel(wheelX, wheelY float64) {
+	d.input.ScrollWheel(wheelX, wheelY)
+	d.Step()
+}
+
+// KeyDown presses the key and steps a frame.
+func (d *Driver) KeyDown(key ebiten.Key) {
+	d.input.PressKey(key)
+	d.Step()
+}
+
+// KeyUp releases the key and steps a frame.
+func (d *Driver) KeyUp(key ebiten.Key) {
+	d.input.ReleaseKey(key)
+	d.Step()
+}
+
+// PressKey presses and releases the key.
+// Modifiers are held down while the key is pressed and released after it.
+func (d *Driver) PressKey(key ebiten.Key, modifiers ...ebiten.Key) {
+	for _, modifier := range modifiers {
+		d.input.PressKey(modifier)
+	}
+
+	d.KeyDown(key)
+	d.KeyUp(key)
+
+	for _, modifier := range modifiers {
+		d.input.ReleaseKey(modifier)
+	}
+
+	if len(modifiers) > 0 {
+		d.Step()
+	}
+}
+
+// PressGamepadButton presses and releases the gamepad button.
+func (d *Driver) PressGamepadButton(button ebiten.StandardGamepadButton) {
+	d.input.PressGamepadButton(button)
+	d.Step()
+
+	d.input.ReleaseGamepadButton(button)
+	d.Step()
+}
+
+// Type types the text as input characters in a single frame.
+// Components can handle the typed characters in the following frames.
+func (d *Driver) Type(text string) {
+	d.input.TypeChars([]rune(text)...)
+	d.Step()
+}
+
+// labelled is implemented by the components with a label.
+type labelled interface {
+	Label() *component.Label
+}
+
+// componentsContainer is implemented by the components that contain other components.
+type componentsContainer interface {
+	Components() []component.Component
+}
+
+// find returns the first visible component in the component's tree labelled with the text.
+func find(c component.Component, text string) component.Component {
+	if c.Hidden() {
+		return nil
+	}
+
+	if lc, ok := c.(labelled); ok {
+		if label := lc.Label(); label != nil && label.Text() == text {
+			return c
+		}
+	}
+
+	if label, ok := c.(*component.Label); ok && label.Text() == text {
+		return c
+	}
+
+	if container, ok := c.(componentsContainer); ok {
+		for _, child := range container.Components() {
+			if found := find(child, text); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
+
+func center(c component.Component) (int, int) {
+	x, y := c.AbsPosition()
+	w, h := c.Dimensions()
+
+	return int(x) + w/2, int(y) + h/2
+}
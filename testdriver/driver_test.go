@@ -0,0 +1,234 @@
+package testdriver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/option"
+	ebiten "github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func newTestDriver(t *testing.T, components ...component.Component) *Driver {
+	t.Helper()
+
+	d := New(200, 200, nil)
+
+	root := d.GUI().NewContainer(&component.ContainerOptions{
+		Layout: &component.VerticalListLayout{RowGap: 5},
+	})
+	d.GUI().SetRootContainer(root)
+	root.AddComponents(components...)
+
+	d.Step()
+
+	return d
+}
+
+func TestDriver_ClickButton(t *testing.T) {
+	is := is.New(t)
+
+	clicked := 0
+
+	btn := component.NewButton(nil)
+	btn.AddClickedHandler(func(args *component.ButtonClickedEventArgs) {
+		clicked++
+	})
+
+	d := newTestDriver(t, btn)
+
+	d.ClickComponent(btn)
+	is.Equal(clicked, 1)
+
+	x, y := btn.AbsPosition()
+	w, h := btn.Dimensions()
+	d.Click(int(x)+w+10, int(y)+h+10)
+	is.Equal(clicked, 1)
+}
+
+func TestDriver_ClickCheckBox(t *testing.T) {
+	is := is.New(t)
+
+	cb := component.NewCheckBox(nil)
+
+	d := newTestDriver(t, cb)
+
+	d.ClickComponent(cb)
+	is.True(cb.Checked())
+
+	d.ClickComponent(cb)
+	is.True(!cb.Checked())
+}
+
+func TestDriver_ClickLabel(t *testing.T) {
+	is := is.New(t)
+
+	clicked := ""
+	newButton := func(text string) *component.Button {
+		btn := component.NewButton(&component.ButtonOptions{Label: component.NewLabel(text, nil)})
+		btn.AddClickedHandler(func(args *component.ButtonClickedEventArgs) {
+			clicked = text
+		})
+
+		return btn
+	}
+
+	cb := component.NewCheckBox(&component.CheckBoxOptions{Label: component.NewLabel("Remember me", nil)})
+	hidden := newButton("Hidden")
+	hidden.SetHidden(true)
+
+	d := newTestDriver(t, newButton("OK"), newButton("Cancel"), cb, hidden)
+
+	is.True(d.ClickLabel("Cancel"))
+	is.Equal(clicked, "Cancel")
+
+	is.True(d.ClickLabel("Remember me"))
+	is.True(cb.Checked())
+
+	is.True(!d.ClickLabel("Hidden")) // hidden components aren't found
+	is.True(!d.ClickLabel("Missing"))
+	is.Equal(clicked, "Cancel")
+
+	// the components of an open dialog are found before the ones below it
+	dialog := d.GUI().NewDialog(&component.DialogOptions{Width: option.Int(50), Height: option.Int(30)})
+	dialogButton := newButton("OK")
+	dialog.AddComponent(dialogButton)
+	d.GUI().OpenDialog(dialog)
+	d.Step()
+
+	is.Equal(d.Find("OK"), dialogButton)
+	is.True(d.ClickLabel("OK"))
+	is.Equal(clicked, "OK")
+}
+
+func TestDriver_DragSlider(t *testing.T) {
+	is := is.New(t)
+
+	slider := component.NewSlider(&component.SliderOptions{
+		Min:          option.Float(0),
+		Max:          option.Float(10),
+		Step:         option.Float(1),
+		DefaultValue: option.Float(0),
+		Width:        option.Int(100),
+	})
+
+	d := newTestDriver(t, slider)
+
+	x, y := slider.AbsPosition()
+	w, h := slider.Dimensions()
+
+	d.Drag(int(x)+1, int(y)+h/2, int(x)+w-1, int(y)+h/2)
+	is.Equal(slider.GetValue(), float64(10))
+}
+
+func TestDriver_TypeIntoTextInput(t *testing.T) {
+	is := is.New(t)
+
+	var changedText string
+
+	ti := component.NewTextInput(&component.TextInputOptions{Width: option.Int(100)})
+	ti.AddChangedHandler(func(args *component.TextInputChangedEventArgs) {
+		changedText = args.Text
+	})
+
+	d := newTestDriver(t, ti)
+
+	d.ClickComponent(ti)
+	is.True(ti.Focused())
+
+	d.Type("abc")
+	d.Step()
+	is.Equal(ti.Value(), "abc")
+	is.Equal(changedText, "abc")
+
+	d.PressKey(ebiten.KeyBackspace)
+	d.Step()
+	is.Equal(ti.Value(), "ab")
+	is.Equal(changedText, "ab")
+}
+
+func TestDriver_ScrollSlider(t *testing.T) {
+	is := is.New(t)
+
+	slided := 0
+
+	slider := component.NewSlider(&component.SliderOptions{
+		Min:          option.Float(0),
+		Max:          option.Float(10),
+		Step:         option.Float(1),
+		DefaultValue: option.Float(5),
+	})
+	slider.AddSlidedHandler(func(args *component.SliderSlidedEventArgs) {
+		slided++
+	})
+
+	d := newTestDriver(t, slider)
+
+	x, y := slider.AbsPosition()
+	d.MoveCursor(int(x)+1, int(y)+1)
+
+	d.ScrollWheel(0, 1)
+	is.Equal(slider.GetValue(), float64(6))
+	is.Equal(slided, 1)
+
+	d.ScrollWheel(0, -1)
+	is.Equal(slider.GetValue(), float64(5))
+	is.Equal(slided, 2)
+}
+
+func TestDriver_HoldKeyInTextInput(t *testing.T) {
+	is := is.New(t)
+
+	ti := component.NewTextInput(&component.TextInputOptions{Width: option.Int(100)})
+
+	d := newTestDriver(t, ti)
+
+	d.ClickComponent(ti)
+	d.Type("abcdefghij")
+	d.Step()
+
+	d.KeyDown(ebiten.KeyBackspace)
+	d.Step()
+	is.Equal(ti.Value(), "abcdefghi")
+
+	d.StepDuration(300 * time.Millisecond)
+	is.Equal(ti.Value(), "abcdefghi") // the key starts repeating after a delay
+
+	d.StepDuration(100 * time.Millisecond)
+	is.True(len(ti.Value()) < len("abcdefghi"))
+
+	d.KeyUp(ebiten.KeyBackspace)
+	value := ti.Value()
+	d.StepDuration(time.Second)
+	is.Equal(ti.Value(), value)
+}
+
+func TestDriver_HoldAutoRepeatButton(t *testing.T) {
+	is := is.New(t)
+
+	clicked := 0
+
+	btn := component.NewButton(&component.ButtonOptions{AutoRepeat: true})
+	btn.AddClickedHandler(func(args *component.ButtonClickedEventArgs) {
+		clicked++
+	})
+
+	d := newTestDriver(t, btn)
+
+	d.MoveCursor(center(btn))
+	d.MouseDown(ebiten.MouseButtonLeft)
+	d.StepDuration(300 * time.Millisecond)
+	is.Equal(clicked, 0)
+
+	d.StepDuration(time.Second)
+	is.True(clicked > 5) // clicks repeatedly while held
+
+	d.MouseUp(ebiten.MouseButtonLeft)
+	clickedWhileHeld := clicked
+	d.StepDuration(time.Second)
+	is.Equal(clicked, clickedWhileHeld) // the release doesn't click again
+
+	d.ClickComponent(btn)
+	is.Equal(clicked, clickedWhileHeld+1) // a short click clicks once
+}
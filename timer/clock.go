@@ -0,0 +1,39 @@
+package timer
+
+import "time"
+
+// Clock is the source of the current time for the scheduler's duration based timers.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock returns the clock reading the system time.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FakeClock is a Clock that only moves when it's advanced. It is meant for tests and headless runs.
+type FakeClock struct {
+	now time.Time
+}
+
+// NewFakeClock creates a fake clock set to the Unix epoch.
+func NewFakeClock() *FakeClock {
+	return &FakeClock{now: time.Unix(0, 0)}
+}
+
+// Now returns the fake clock's time.
+func (c *FakeClock) Now() time.Time {
+	return c.now
+}
+
+// Advance moves the fake clock forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
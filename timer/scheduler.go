@@ -0,0 +1,175 @@
+package timer
+
+import "time"
+
+// Scheduler calls functions after or every given number of frames or duration.
+// It is driven by Tick, which the GUI calls once per Update, so the functions are called on the game loop's goroutine.
+//
+// Methods of a nil scheduler do nothing and return nil handles.
+type Scheduler struct {
+	clock Clock
+	frame uint64
+
+	tasks []*Handle
+}
+
+// Handle is a scheduled function. It can be used to cancel the function before it's called.
+type Handle struct {
+	f func()
+
+	// the task is due at the frame or, if it's timed, at the deadline
+	frame    uint64
+	timed    bool
+	deadline time.Time
+	duration time.Duration
+
+	// interval is the number of frames between calls of repeated frame based tasks
+	interval uint64
+	repeated bool
+
+	cancelled bool
+	done      bool
+}
+
+// NewScheduler creates a scheduler reading the time from the clock. If clock is nil, the system clock is used.
+func NewScheduler(clock Clock) *Scheduler {
+	if clock == nil {
+		clock = SystemClock()
+	}
+
+	return &Scheduler{
+		clock: clock,
+	}
+}
+
+// Frame returns the number of ticks since the scheduler was created.
+func (s *Scheduler) Frame() uint64 {
+	if s == nil {
+		return 0
+	}
+
+	return s.frame
+}
+
+// Clock returns the scheduler's clock.
+func (s *Scheduler) Clock() Clock {
+	if s == nil {
+		return nil
+	}
+
+	return s.clock
+}
+
+// Now returns the current time of the scheduler's clock.
+func (s *Scheduler) Now() time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+
+	return s.clock.Now()
+}
+
+// AfterFrames calls f once, frames ticks from now.
+func (s *Scheduler) AfterFrames(frames int, f func()) *Handle {
+	return s.schedule(&Handle{f: f, frame: s.Frame() + positive(frames)})
+}
+
+// EveryFrames calls f every frames ticks until it's cancelled.
+func (s *Scheduler) EveryFrames(frames int, f func()) *Handle {
+	interval := positive(frames)
+	return s.schedule(&Handle{f: f, frame: s.Frame() + interval, interval: interval, repeated: true})
+}
+
+// After calls f once, on the first tick after the duration d passed.
+func (s *Scheduler) After(d time.Duration, f func()) *Handle {
+	return s.schedule(&Handle{f: f, timed: true, deadline: s.Now().Add(d), duration: d})
+}
+
+// Every calls f on the first tick after every duration d until it's cancelled.
+// If several durations passed between two ticks, f is called once.
+func (s *Scheduler) Every(d time.Duration, f func()) *Handle {
+	return s.schedule(&Handle{f: f, timed: true, deadline: s.Now().Add(d), duration: d, repeated: true})
+}
+
+func (s *Scheduler) schedule(h *Handle) *Handle {
+	if s == nil {
+		return nil
+	}
+
+	s.tasks = append(s.tasks, h)
+
+	return h
+}
+
+// Tick advances the scheduler by a frame and calls the functions that are due.
+// Functions scheduled by the called functions are due at the earliest on the next tick.
+func (s *Scheduler) Tick() {
+	if s == nil {
+		return
+	}
+
+	s.frame++
+	now := s.clock.Now()
+
+	tasks := s.tasks
+	s.tasks = nil
+
+	for _, h := range tasks {
+		if !h.cancelled && h.due(s.frame, now) {
+			if h.repeated {
+				h.reschedule(s.frame, now)
+			} else {
+				h.done = true
+			}
+
+			h.f()
+		}
+
+		if h.Active() {
+			s.tasks = append(s.tasks, h)
+		}
+	}
+}
+
+func (h *Handle) due(frame uint64, now time.Time) bool {
+	if h.timed {
+		return !now.Before(h.deadline)
+	}
+
+	return frame >= h.frame
+}
+
+func (h *Handle) reschedule(frame uint64, now time.Time) {
+	if h.timed {
+		h.deadline = h.deadline.Add(h.duration)
+		if !h.deadline.After(now) {
+			h.deadline = now.Add(h.duration)
+		}
+
+		return
+	}
+
+	h.frame = frame + h.interval
+}
+
+// Cancel stops the function from being called. Cancelling a nil or finished handle does nothing.
+func (h *Handle) Cancel() {
+	if h == nil {
+		return
+	}
+
+	h.cancelled = true
+}
+
+// Active returns whether the function is still going to be called.
+func (h *Handle) Active() bool {
+	return h != nil && !h.cancelled && !h.done
+}
+
+func positive(frames int) uint64 {
+	if frames < 1 {
+		return 1
+	}
+
+	return uint64(frames)
+}
@@ -0,0 +1,131 @@
+package timer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestScheduler_AfterFrames(t *testing.T) {
+	is := is.New(t)
+
+	s := NewScheduler(NewFakeClock())
+
+	calls := 0
+	h := s.AfterFrames(2, func() { calls++ })
+
+	s.Tick()
+	is.Equal(calls, 0)
+	is.True(h.Active())
+
+	s.Tick()
+	is.Equal(calls, 1)
+	is.True(!h.Active())
+
+	s.Tick()
+	is.Equal(calls, 1)
+	is.Equal(s.Frame(), uint64(3))
+}
+
+func TestScheduler_EveryFrames(t *testing.T) {
+	is := is.New(t)
+
+	s := NewScheduler(NewFakeClock())
+
+	calls := 0
+	h := s.EveryFrames(2, func() { calls++ })
+
+	for i := 0; i < 6; i++ {
+		s.Tick()
+	}
+	is.Equal(calls, 3)
+
+	h.Cancel()
+	s.Tick()
+	s.Tick()
+	is.Equal(calls, 3)
+	is.True(!h.Active())
+}
+
+func TestScheduler_After(t *testing.T) {
+	is := is.New(t)
+
+	clock := NewFakeClock()
+	s := NewScheduler(clock)
+
+	calls := 0
+	s.After(100*time.Millisecond, func() { calls++ })
+
+	clock.Advance(99 * time.Millisecond)
+	s.Tick()
+	is.Equal(calls, 0)
+
+	clock.Advance(time.Millisecond)
+	is.Equal(calls, 0) // called only by a tick
+	s.Tick()
+	is.Equal(calls, 1)
+
+	clock.Advance(time.Second)
+	s.Tick()
+	is.Equal(calls, 1)
+}
+
+func TestScheduler_Every(t *testing.T) {
+	is := is.New(t)
+
+	clock := NewFakeClock()
+	s := NewScheduler(clock)
+
+	calls := 0
+	h := s.Every(10*time.Millisecond, func() { calls++ })
+
+	for i := 0; i < 5; i++ {
+		clock.Advance(10 * time.Millisecond)
+		s.Tick()
+	}
+	is.Equal(calls, 5)
+
+	clock.Advance(time.Second)
+	s.Tick()
+	is.Equal(calls, 6) // missed calls aren't made up for
+
+	h.Cancel()
+	clock.Advance(time.Second)
+	s.Tick()
+	is.Equal(calls, 6)
+}
+
+func TestScheduler_scheduledWhileTicking(t *testing.T) {
+	is := is.New(t)
+
+	s := NewScheduler(NewFakeClock())
+
+	var calls []string
+	var second *Handle
+	s.AfterFrames(1, func() {
+		calls = append(calls, "first")
+		second.Cancel()
+		s.AfterFrames(0, func() { calls = append(calls, "third") })
+	})
+	second = s.AfterFrames(1, func() { calls = append(calls, "second") })
+
+	s.Tick()
+	is.Equal(calls, []string{"first"}) // the second was cancelled, the third is due on the next tick
+
+	s.Tick()
+	is.Equal(calls, []string{"first", "third"})
+}
+
+func TestScheduler_nil(t *testing.T) {
+	is := is.New(t)
+
+	var s *Scheduler
+
+	h := s.After(time.Second, func() {})
+	s.Tick()
+	h.Cancel()
+
+	is.True(h == nil)
+	is.True(!h.Active())
+}
@@ -0,0 +1,58 @@
+package chopstiqs
+
+import (
+	"github.com/fglo/chopstiqs/component"
+)
+
+const (
+	// tooltipOffsetX and tooltipOffsetY are the distance between the cursor and the tooltip's top left corner
+	tooltipOffsetX = 8
+	tooltipOffsetY = 16
+)
+
+// handleTooltipEvent shows the tooltips of the components on the tooltip layer, so they aren't clipped by the components' containers.
+func (gui *GUI) handleTooltipEvent(args *component.ComponentTooltipEventArgs) {
+	if !args.Shown {
+		if gui.tooltip == args.Tooltip {
+			gui.hideTooltip()
+		}
+
+		return
+	}
+
+	gui.hideTooltip()
+
+	gui.tooltip = args.Tooltip
+	content := gui.tooltip.Content()
+	gui.AddToLayer(LayerTooltip, content)
+	gui.positionTooltip(content)
+}
+
+func (gui *GUI) hideTooltip() {
+	if gui.tooltip == nil {
+		return
+	}
+
+	gui.RemoveFromLayer(LayerTooltip, gui.tooltip.Content())
+	gui.tooltip = nil
+}
+
+// positionTooltip places the tooltip below and to the right of the cursor, keeping it within the screen.
+// If there isn't enough space below the cursor, the tooltip is placed above it.
+func (gui *GUI) positionTooltip(content component.Component) {
+	screenWidth, screenHeight := gui.layers[LayerTooltip].Dimensions()
+	cursorX, cursorY := gui.input.CursorPosition()
+	width, height := content.WidthWithPadding(), content.HeightWithPadding()
+
+	x := cursorX + tooltipOffsetX
+	if x+width > screenWidth {
+		x = screenWidth - width
+	}
+
+	y := cursorY + tooltipOffsetY
+	if y+height > screenHeight {
+		y = cursorY - height
+	}
+
+	content.SetPosition(float64(max(x, 0)), float64(max(y, 0)))
+}
@@ -0,0 +1,64 @@
+package chopstiqs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/component"
+	"github.com/fglo/chopstiqs/option"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/matryer/is"
+)
+
+func TestGUI_tooltip(t *testing.T) {
+	is := is.New(t)
+
+	clock := timer.NewFakeClock()
+	tooltip := component.NewTextTooltip("help", &component.TooltipOptions{Delay: time.Second})
+	b := component.NewButton(&component.ButtonOptions{Tooltip: tooltip})
+	gui, in := newTestGUIWithOptions(t, &GUIOptions{Clock: clock}, b)
+	step(t, gui)
+
+	x, y := b.AbsPosition()
+	in.MoveCursor(int(x)+1, int(y)+1)
+	step(t, gui)
+
+	clock.Advance(time.Second)
+	step(t, gui)
+
+	layer := gui.layers[LayerTooltip].Components()
+	is.Equal(len(layer), 1)
+	is.Equal(layer[0], tooltip.Content())
+	is.Equal(tooltip.Content().PosX(), x+1+tooltipOffsetX)
+	is.Equal(tooltip.Content().PosY(), y+1+tooltipOffsetY)
+	is.True(b.CursorOver()) // the tooltip doesn't take the mouse input
+
+	in.MoveCursor(int(x)+1, int(y)+100)
+	step(t, gui)
+	is.Equal(len(gui.layers[LayerTooltip].Components()), 0)
+}
+
+func TestGUI_tooltip_withinScreen(t *testing.T) {
+	is := is.New(t)
+
+	clock := timer.NewFakeClock()
+	tooltip := component.NewTextTooltip("a long explanation", &component.TooltipOptions{Delay: time.Second})
+	b := component.NewButton(&component.ButtonOptions{
+		Width:   option.Int(200),
+		Height:  option.Int(200),
+		Tooltip: tooltip,
+	})
+	gui, in := newTestGUIWithOptions(t, &GUIOptions{Clock: clock}, b)
+	step(t, gui)
+
+	// the gui image is 200x200
+	in.MoveCursor(195, 195)
+	step(t, gui)
+
+	clock.Advance(time.Second)
+	step(t, gui)
+
+	content := tooltip.Content()
+	is.Equal(content.PosX(), float64(200-content.WidthWithPadding()))
+	is.Equal(content.PosY(), float64(195-content.HeightWithPadding())) // above the cursor
+}
@@ -0,0 +1,85 @@
+package tween
+
+import (
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/timer"
+)
+
+// Animator plays animations. It advances them on every tick of its scheduler
+// by the time passed on the scheduler's clock since the previous tick.
+type Animator struct {
+	scheduler    *timer.Scheduler
+	eventManager *event.Manager
+
+	playing []Animation
+
+	task     *timer.Handle
+	lastTime time.Time
+}
+
+// NewAnimator creates an animator driven by the scheduler. Finished events of the played animations are fired with the event manager.
+func NewAnimator(scheduler *timer.Scheduler, eventManager *event.Manager) *Animator {
+	return &Animator{
+		scheduler:    scheduler,
+		eventManager: eventManager,
+	}
+}
+
+// Play starts playing the animation from its beginning. An animation that is already playing is restarted.
+func (an *Animator) Play(a Animation) {
+	an.Stop(a)
+
+	a.Reset()
+	a.setEventManager(an.eventManager)
+	an.playing = append(an.playing, a)
+
+	if !an.task.Active() {
+		an.lastTime = an.scheduler.Now()
+		an.task = an.scheduler.EveryFrames(1, an.update)
+	}
+}
+
+// Stop stops playing the animation, leaving its values where they are.
+func (an *Animator) Stop(a Animation) {
+	for i, playing := range an.playing {
+		if playing == a {
+			an.playing = append(an.playing[:i:i], an.playing[i+1:]...)
+			return
+		}
+	}
+}
+
+// Playing returns whether the animation is playing.
+func (an *Animator) Playing(a Animation) bool {
+	for _, playing := range an.playing {
+		if playing == a {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (an *Animator) update() {
+	now := an.scheduler.Now()
+	dt := now.Sub(an.lastTime)
+	an.lastTime = now
+
+	playing := an.playing
+	for _, a := range playing {
+		if !an.Playing(a) {
+			// stopped by one of the animations updated before
+			continue
+		}
+
+		if finished, _ := a.Update(dt); finished {
+			an.Stop(a)
+		}
+	}
+
+	if len(an.playing) == 0 {
+		an.task.Cancel()
+	}
+}
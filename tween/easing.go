@@ -0,0 +1,93 @@
+package tween
+
+import "math"
+
+// Easing maps the linear progress of a tween, from 0 to 1, to the eased progress.
+// The eased progress starts at 0 and ends at 1, but can go outside of the range in between, like with OutBack.
+type Easing func(t float64) float64
+
+// Linear progresses at a constant speed.
+func Linear(t float64) float64 {
+	return t
+}
+
+// InQuad starts slow and accelerates.
+func InQuad(t float64) float64 {
+	return t * t
+}
+
+// OutQuad starts fast and decelerates.
+func OutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// InOutQuad accelerates until halfway and then decelerates.
+func InOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+
+	return 1 - math.Pow(-2*t+2, 2)/2
+}
+
+// InCubic starts slow and accelerates, more sharply than InQuad.
+func InCubic(t float64) float64 {
+	return t * t * t
+}
+
+// OutCubic starts fast and decelerates, more sharply than OutQuad.
+func OutCubic(t float64) float64 {
+	return 1 - math.Pow(1-t, 3)
+}
+
+// InOutCubic accelerates until halfway and then decelerates, more sharply than InOutQuad.
+func InOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+// InSine starts slow and accelerates along a sine curve.
+func InSine(t float64) float64 {
+	return 1 - math.Cos(t*math.Pi/2)
+}
+
+// OutSine starts fast and decelerates along a sine curve.
+func OutSine(t float64) float64 {
+	return math.Sin(t * math.Pi / 2)
+}
+
+// InOutSine accelerates until halfway and then decelerates along a sine curve.
+func InOutSine(t float64) float64 {
+	return -(math.Cos(math.Pi*t) - 1) / 2
+}
+
+// OutBack overshoots the end and settles back.
+func OutBack(t float64) float64 {
+	const c1 = 1.70158
+	const c3 = c1 + 1
+
+	return 1 + c3*math.Pow(t-1, 3) + c1*math.Pow(t-1, 2)
+}
+
+// OutBounce bounces off the end like a dropped ball.
+func OutBounce(t float64) float64 {
+	const n1 = 7.5625
+	const d1 = 2.75
+
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
@@ -0,0 +1,119 @@
+package tween
+
+import (
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+)
+
+// Group is an animation made of other animations, played one after another or all at once.
+type Group struct {
+	animation
+
+	animations []Animation
+	sequential bool
+	// current is the index of the animation played by a sequence
+	current int
+}
+
+// Sequence creates a group playing the animations one after another.
+func Sequence(animations ...Animation) *Group {
+	return &Group{
+		animation:  newAnimation(),
+		animations: animations,
+		sequential: true,
+	}
+}
+
+// Parallel creates a group playing the animations at once. It finishes when all of them finished.
+func Parallel(animations ...Animation) *Group {
+	return &Group{
+		animation:  newAnimation(),
+		animations: animations,
+	}
+}
+
+// Update advances the group's animations by dt.
+func (g *Group) Update(dt time.Duration) (bool, time.Duration) {
+	if g.finished {
+		return true, dt
+	}
+
+	var finished bool
+	var rest time.Duration
+
+	if g.sequential {
+		finished, rest = g.updateSequence(dt)
+	} else {
+		finished, rest = g.updateParallel(dt)
+	}
+
+	if finished {
+		g.finish(g)
+	}
+
+	return finished, rest
+}
+
+func (g *Group) updateSequence(dt time.Duration) (bool, time.Duration) {
+	rest := dt
+
+	for g.current < len(g.animations) {
+		var finished bool
+		finished, rest = g.animations[g.current].Update(rest)
+
+		if !finished {
+			return false, 0
+		}
+
+		g.current++
+	}
+
+	return true, rest
+}
+
+func (g *Group) updateParallel(dt time.Duration) (bool, time.Duration) {
+	allFinished := true
+	// the group finished when its longest animation did, so it leaves the shortest rest
+	rest := dt
+
+	for _, a := range g.animations {
+		if a.Finished() {
+			continue
+		}
+
+		finished, animationRest := a.Update(dt)
+		if !finished {
+			allFinished = false
+			continue
+		}
+
+		if animationRest < rest {
+			rest = animationRest
+		}
+	}
+
+	if !allFinished {
+		return false, 0
+	}
+
+	return true, rest
+}
+
+// Reset rewinds the group and its animations.
+func (g *Group) Reset() {
+	g.finished = false
+	g.current = 0
+
+	for _, a := range g.animations {
+		a.Reset()
+	}
+}
+
+func (g *Group) setEventManager(eventManager *event.Manager) {
+	g.animation.setEventManager(eventManager)
+
+	for _, a := range g.animations {
+		a.setEventManager(eventManager)
+	}
+}
@@ -0,0 +1,193 @@
+// Package tween animates values, like the positions, sizes and colours of components, over time.
+package tween
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+)
+
+// Animation is a tween or a group of animations played by an Animator.
+type Animation interface {
+	// Update advances the animation by dt. It returns whether the animation finished
+	// and the part of dt left after it finished.
+	Update(dt time.Duration) (finished bool, rest time.Duration)
+	// Reset rewinds the animation, so it can be played again.
+	Reset()
+	// Finished returns whether the animation finished.
+	Finished() bool
+	// AddFinishedHandler registers a handler called when the animation finishes.
+	AddFinishedHandler(f FinishedHandlerFunc) event.RemoveHandlerFunc
+
+	setEventManager(eventManager *event.Manager)
+}
+
+// FinishedEventArgs are the arguments of the event fired when an animation finishes.
+type FinishedEventArgs struct {
+	Animation Animation
+}
+
+// FinishedHandlerFunc is a function that handles the finished events of animations.
+type FinishedHandlerFunc func(args *FinishedEventArgs)
+
+// animation holds the finished state and event shared by tweens and groups.
+type animation struct {
+	eventManager *event.Manager
+
+	finished bool
+
+	FinishedEvent *event.Event[*FinishedEventArgs]
+}
+
+func newAnimation() animation {
+	return animation{
+		FinishedEvent: &event.Event[*FinishedEventArgs]{},
+	}
+}
+
+// Finished returns whether the animation finished.
+func (a *animation) Finished() bool {
+	return a.finished
+}
+
+// AddFinishedHandler registers a handler called when the animation finishes.
+func (a *animation) AddFinishedHandler(f FinishedHandlerFunc) event.RemoveHandlerFunc {
+	return a.FinishedEvent.AddHandler(event.HandlerFunc[*FinishedEventArgs](f))
+}
+
+func (a *animation) setEventManager(eventManager *event.Manager) {
+	a.eventManager = eventManager
+}
+
+func (a *animation) finish(self Animation) {
+	a.finished = true
+	event.Fire(a.eventManager, a.FinishedEvent, &FinishedEventArgs{
+		Animation: self,
+	})
+}
+
+// Tween changes a value over its duration, following its easing curve.
+type Tween struct {
+	animation
+
+	duration time.Duration
+	elapsed  time.Duration
+	easing   Easing
+
+	started bool
+	onStart func()
+	update  func(progress float64)
+}
+
+// New creates a tween calling update with the eased progress, from 0 to 1, every time it's advanced.
+// If easing is nil, the tween progresses linearly.
+func New(duration time.Duration, easing Easing, update func(progress float64)) *Tween {
+	if easing == nil {
+		easing = Linear
+	}
+
+	return &Tween{
+		animation: newAnimation(),
+		duration:  duration,
+		easing:    easing,
+		update:    update,
+	}
+}
+
+// Float creates a tween changing a value from one number to another.
+func Float(from, to float64, duration time.Duration, easing Easing, set func(value float64)) *Tween {
+	return New(duration, easing, func(progress float64) {
+		set(Lerp(from, to, progress))
+	})
+}
+
+// Color creates a tween changing a value from one colour to another.
+func Color(from, to color.RGBA, duration time.Duration, easing Easing, set func(color.RGBA)) *Tween {
+	return New(duration, easing, func(progress float64) {
+		set(LerpColor(from, to, progress))
+	})
+}
+
+// OnStart sets a function called when the tween is first advanced, before its first update.
+// It can be used to read the value the tween starts from when it's played in a sequence.
+func (t *Tween) OnStart(f func()) *Tween {
+	t.onStart = f
+	return t
+}
+
+// Update advances the tween by dt.
+func (t *Tween) Update(dt time.Duration) (bool, time.Duration) {
+	if t.finished {
+		return true, dt
+	}
+
+	if !t.started {
+		t.started = true
+		if t.onStart != nil {
+			t.onStart()
+		}
+	}
+
+	t.elapsed += dt
+
+	if t.elapsed < t.duration {
+		t.update(t.easing(float64(t.elapsed) / float64(t.duration)))
+		return false, 0
+	}
+
+	rest := t.elapsed - t.duration
+	t.elapsed = t.duration
+	t.update(t.easing(1))
+	t.finish(t)
+
+	return true, rest
+}
+
+// Reset rewinds the tween. It's started again when it's advanced next time.
+func (t *Tween) Reset() {
+	t.elapsed = 0
+	t.started = false
+	t.finished = false
+}
+
+// Progress returns the tween's linear progress, from 0 to 1.
+func (t *Tween) Progress() float64 {
+	if t.duration <= 0 {
+		if t.finished {
+			return 1
+		}
+
+		return 0
+	}
+
+	return float64(t.elapsed) / float64(t.duration)
+}
+
+// Lerp interpolates linearly between two numbers.
+func Lerp(from, to, progress float64) float64 {
+	return from + (to-from)*progress
+}
+
+// LerpColor interpolates linearly between two colours, channel by channel.
+func LerpColor(from, to color.RGBA, progress float64) color.RGBA {
+	channel := func(from, to uint8) uint8 {
+		v := Lerp(float64(from), float64(to), progress)
+
+		switch {
+		case v < 0:
+			return 0
+		case v > 255:
+			return 255
+		default:
+			return uint8(v + 0.5)
+		}
+	}
+
+	return color.RGBA{
+		R: channel(from.R, to.R),
+		G: channel(from.G, to.G),
+		B: channel(from.B, to.B),
+		A: channel(from.A, to.A),
+	}
+}
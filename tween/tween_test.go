@@ -0,0 +1,199 @@
+package tween
+
+import (
+	"image/color"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fglo/chopstiqs/event"
+	"github.com/fglo/chopstiqs/timer"
+	"github.com/matryer/is"
+)
+
+func TestEasings(t *testing.T) {
+	is := is.New(t)
+
+	easings := map[string]Easing{
+		"Linear":     Linear,
+		"InQuad":     InQuad,
+		"OutQuad":    OutQuad,
+		"InOutQuad":  InOutQuad,
+		"InCubic":    InCubic,
+		"OutCubic":   OutCubic,
+		"InOutCubic": InOutCubic,
+		"InSine":     InSine,
+		"OutSine":    OutSine,
+		"InOutSine":  InOutSine,
+		"OutBack":    OutBack,
+		"OutBounce":  OutBounce,
+	}
+
+	for name, easing := range easings {
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+			is.True(math.Abs(easing(0)) < 1e-9)   // starts at 0
+			is.True(math.Abs(easing(1)-1) < 1e-9) // ends at 1
+		})
+	}
+
+	is.True(InQuad(0.5) < 0.5)
+	is.True(OutQuad(0.5) > 0.5)
+	is.Equal(InOutQuad(0.5), 0.5)
+	is.True(OutBack(0.8) > 1) // overshoots
+}
+
+func TestTween_Update(t *testing.T) {
+	is := is.New(t)
+
+	var value float64
+	tw := Float(10, 20, time.Second, Linear, func(v float64) { value = v })
+
+	finished, _ := tw.Update(250 * time.Millisecond)
+	is.True(!finished)
+	is.Equal(value, 12.5)
+	is.Equal(tw.Progress(), 0.25)
+
+	finished, rest := tw.Update(time.Second)
+	is.True(finished)
+	is.Equal(rest, 250*time.Millisecond)
+	is.Equal(value, 20.0)
+	is.True(tw.Finished())
+
+	tw.Reset()
+	tw.Update(500 * time.Millisecond)
+	is.Equal(value, 15.0)
+}
+
+func TestTween_OnStart(t *testing.T) {
+	is := is.New(t)
+
+	value := 0.0
+	from := 0.0
+	tw := New(time.Second, Linear, func(progress float64) {
+		value = Lerp(from, 10, progress)
+	}).OnStart(func() {
+		from = value
+	})
+
+	value = 5
+	tw.Update(500 * time.Millisecond)
+	is.Equal(value, 7.5) // starts from the value it had when first advanced
+}
+
+func TestLerpColor(t *testing.T) {
+	is := is.New(t)
+
+	c := LerpColor(color.RGBA{0, 100, 200, 255}, color.RGBA{100, 200, 0, 255}, 0.5)
+	is.Equal(c, color.RGBA{50, 150, 100, 255})
+}
+
+func TestSequence(t *testing.T) {
+	is := is.New(t)
+
+	var a, b float64
+	seq := Sequence(
+		Float(0, 10, time.Second, Linear, func(v float64) { a = v }),
+		Float(0, 10, time.Second, Linear, func(v float64) { b = v }),
+	)
+
+	finished, _ := seq.Update(1500 * time.Millisecond)
+	is.True(!finished)
+	is.Equal(a, 10.0)
+	is.Equal(b, 5.0) // the time left after the first tween is passed to the second one
+
+	finished, rest := seq.Update(time.Second)
+	is.True(finished)
+	is.Equal(rest, 500*time.Millisecond)
+	is.Equal(b, 10.0)
+}
+
+func TestParallel(t *testing.T) {
+	is := is.New(t)
+
+	var a, b float64
+	par := Parallel(
+		Float(0, 10, time.Second, Linear, func(v float64) { a = v }),
+		Float(0, 10, 2*time.Second, Linear, func(v float64) { b = v }),
+	)
+
+	finished, _ := par.Update(time.Second)
+	is.True(!finished)
+	is.Equal(a, 10.0)
+	is.Equal(b, 5.0)
+
+	finished, rest := par.Update(1500 * time.Millisecond)
+	is.True(finished)
+	is.Equal(rest, 500*time.Millisecond)
+	is.Equal(b, 10.0)
+}
+
+func TestAnimator(t *testing.T) {
+	is := is.New(t)
+
+	clock := timer.NewFakeClock()
+	scheduler := timer.NewScheduler(clock)
+	eventManager := event.NewManager()
+	animator := NewAnimator(scheduler, eventManager)
+
+	step := func(d time.Duration) {
+		clock.Advance(d)
+		scheduler.Tick()
+		eventManager.HandleFired()
+	}
+
+	var value float64
+	tw := Float(0, 100, time.Second, Linear, func(v float64) { value = v })
+
+	finishedCounter := 0
+	tw.AddFinishedHandler(func(args *FinishedEventArgs) {
+		is.Equal(args.Animation, tw)
+		finishedCounter++
+	})
+
+	animator.Play(tw)
+	is.True(animator.Playing(tw))
+
+	step(500 * time.Millisecond)
+	is.Equal(value, 50.0)
+
+	step(time.Second)
+	is.Equal(value, 100.0)
+	is.Equal(finishedCounter, 1)
+	is.True(!animator.Playing(tw))
+
+	animator.Play(tw) // played again from the beginning
+	step(250 * time.Millisecond)
+	is.Equal(value, 25.0)
+
+	animator.Stop(tw)
+	step(250 * time.Millisecond)
+	is.Equal(value, 25.0)
+	is.Equal(finishedCounter, 1)
+}
+
+func TestAnimator_groupFinishedEvents(t *testing.T) {
+	is := is.New(t)
+
+	clock := timer.NewFakeClock()
+	scheduler := timer.NewScheduler(clock)
+	eventManager := event.NewManager()
+	animator := NewAnimator(scheduler, eventManager)
+
+	first := New(time.Second, Linear, func(float64) {})
+	group := Sequence(first, New(time.Second, Linear, func(float64) {}))
+
+	var finished []Animation
+	first.AddFinishedHandler(func(args *FinishedEventArgs) { finished = append(finished, args.Animation) })
+	group.AddFinishedHandler(func(args *FinishedEventArgs) { finished = append(finished, args.Animation) })
+
+	animator.Play(group)
+
+	clock.Advance(3 * time.Second)
+	scheduler.Tick()
+	eventManager.HandleFired()
+
+	is.Equal(len(finished), 2)
+	is.Equal(finished[0], first)
+	is.Equal(finished[1], group)
+}